@@ -59,7 +59,7 @@ func CreateSight(ctx context.Context, client ufo_v1.UFOServiceClient) (string, e
 	}
 
 	if gofakeit.Bool() {
-		info.DurationSeconds = wrapperspb.Int32(gofakeit.Int32())
+		info.DurationSeconds = wrapperspb.Int32(int32(gofakeit.Number(1, 3600)))
 	}
 
 	resp, err := client.Create(ctx, &ufo_v1.CreateRequest{Info: info})
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/serverconfig"
+	"google.golang.org/grpc"
+)
+
+// Config конфигурация сервера: YAML (-config или UFO_CONFIG), переменные
+// окружения UFO_* и флаги, см. serverconfig.Load.
+type Config struct {
+	GRPC    serverconfig.GRPCConfig    `yaml:"grpc"`
+	Storage serverconfig.StorageConfig `yaml:"storage"`
+	Log     serverconfig.LogConfig     `yaml:"log"`
+}
+
+func defaultConfig() Config {
+	return Config{
+		GRPC: serverconfig.GRPCConfig{
+			Port:            50051,
+			ShutdownTimeout: 10 * time.Second,
+		},
+		Storage: serverconfig.StorageConfig{Backend: serverconfig.StorageMemory},
+		Log:     serverconfig.LogConfig{Level: "info", Format: "text"},
+	}
+}
+
+// Validate проверяет конфигурацию и возвращает все найденные ошибки.
+func (c Config) Validate() error {
+	return errors.Join(
+		c.GRPC.Validate("grpc"),
+		c.Storage.Validate("storage"),
+		c.Log.Validate("log"),
+	)
+}
+
+func loadConfig(name string, args []string) (Config, error) {
+	cfg := defaultConfig()
+	if err := serverconfig.Load(name, args, &cfg); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// gracefulStop ждет завершения активных вызовов не дольше timeout, затем
+// закрывает соединения принудительно.
+func gracefulStop(s *grpc.Server, timeout time.Duration) {
+	done := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		log.Printf("⚠️ Graceful stop timed out after %v, forcing stop\n", timeout)
+		s.Stop()
+	}
+}
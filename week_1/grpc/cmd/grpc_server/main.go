@@ -2,7 +2,6 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"net"
 	"os"
@@ -13,6 +12,7 @@ import (
 
 	"github.com/google/uuid"
 	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc/pkg/proto/ufo/v1"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/serverconfig"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
@@ -21,8 +21,6 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-const grpcPort = 50051
-
 type ufoService struct {
 	ufo_v1.UnimplementedUFOServiceServer
 
@@ -134,7 +132,14 @@ func (s *ufoService) Create(_ context.Context, req *ufo_v1.CreateRequest) (*ufo_
 }
 
 func main() {
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
+	cfg, err := loadConfig(os.Args[0], os.Args[1:])
+	if err != nil {
+		log.Fatalf("failed to load config: %v\n", err)
+	}
+	serverconfig.SetupLogger(cfg.Log)
+	log.Printf("⚙️ Effective config:\n%s", serverconfig.Format(cfg))
+
+	lis, err := net.Listen("tcp", cfg.GRPC.Address())
 	if err != nil {
 		log.Printf("failed to listen: %v\n", err)
 		return
@@ -168,6 +173,6 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	log.Println("🛑 Shutting down gRPC server...")
-	s.GracefulStop()
+	gracefulStop(s, cfg.GRPC.ShutdownTimeout)
 	log.Println("✅ Server stopped")
 }
@@ -5,6 +5,7 @@ go 1.25.4
 require (
 	github.com/brianvoe/gofakeit/v7 v7.11.0
 	github.com/google/uuid v1.6.0
+	github.com/mbakhodurov/examples/week_1/grpc_with_interceptor v0.0.0-00010101000000-000000000000
 	google.golang.org/grpc v1.77.0
 	google.golang.org/protobuf v1.36.10
 )
@@ -13,5 +14,8 @@ require (
 	golang.org/x/net v0.46.1-0.20251013234738-63d1a5100f82 // indirect
 	golang.org/x/sys v0.37.0 // indirect
 	golang.org/x/text v0.30.0 // indirect
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20251022142026-3a174f9686a8 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20251111163417-95abcf5c77ba // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/mbakhodurov/examples/week_1/grpc_with_interceptor => ../grpc_with_interceptor
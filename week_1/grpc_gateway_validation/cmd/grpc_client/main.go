@@ -40,7 +40,7 @@ func CreateSighting(ctx context.Context, client ufoV1.UFOServiceClient) (string,
 	}
 
 	if gofakeit.Bool() {
-		info.DurationSeconds = wrapperspb.Int32(gofakeit.Int32())
+		info.DurationSeconds = wrapperspb.Int32(int32(gofakeit.Number(1, 3600)))
 	}
 
 	resp, err := client.Create(ctx, &ufoV1.CreateRequest{Info: info})
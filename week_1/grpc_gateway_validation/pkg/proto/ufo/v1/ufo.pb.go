@@ -28,17 +28,17 @@ const (
 
 type SightingInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// observed_at время наблюдения НЛО
+	// observed_at время наблюдения НЛО, не в будущем (проверяется сервером, не правилами validate)
 	ObservedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=observed_at,json=observedAt,proto3" json:"observed_at,omitempty"`
 	// location место наблюдения
 	Location string `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
-	// description описание наблюдаемого объекта
+	// description описание наблюдаемого объекта, до 1000 символов
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	// color цвет объекта (опционально)
 	Color *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
 	// sound признак наличия звука (опционально)
 	Sound *wrapperspb.BoolValue `protobuf:"bytes,5,opt,name=sound,proto3" json:"sound,omitempty"`
-	// duration_seconds продолжительность наблюдения в секундах (опционально)
+	// duration_seconds продолжительность наблюдения в секундах, от 1 до 86400 (опционально)
 	DurationSeconds *wrapperspb.Int32Value `protobuf:"bytes,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
@@ -119,17 +119,17 @@ func (x *SightingInfo) GetDurationSeconds() *wrapperspb.Int32Value {
 // SightingUpdateInfo информация о наблюдении НЛО для обновления (все поля опциональны)
 type SightingUpdateInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// observed_at время наблюдения НЛО (опционально)
+	// observed_at время наблюдения НЛО (опционально), не в будущем (проверяется сервером)
 	ObservedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=observed_at,json=observedAt,proto3" json:"observed_at,omitempty"`
 	// location место наблюдения (опционально)
 	Location *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
-	// description описание наблюдаемого объекта (опционально)
+	// description описание наблюдаемого объекта (опционально), до 1000 символов
 	Description *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	// color цвет объекта (опционально)
 	Color *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
 	// sound признак наличия звука (опционально)
 	Sound *wrapperspb.BoolValue `protobuf:"bytes,5,opt,name=sound,proto3" json:"sound,omitempty"`
-	// duration_seconds продолжительность наблюдения в секундах (опционально)
+	// duration_seconds продолжительность наблюдения в секундах, от 1 до 86400 (опционально)
 	DurationSeconds *wrapperspb.Int32Value `protobuf:"bytes,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
@@ -666,23 +666,23 @@ var File_ufo_v1_ufo_proto protoreflect.FileDescriptor
 
 const file_ufo_v1_ufo_proto_rawDesc = "" +
 	"\n" +
-	"\x10ufo/v1/ufo.proto\x12\x06ufo.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"\xc2\x02\n" +
+	"\x10ufo/v1/ufo.proto\x12\x06ufo.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"\xe4\x02\n" +
 	"\fSightingInfo\x12;\n" +
 	"\vobserved_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"observedAt\x12%\n" +
-	"\blocation\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\blocation\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x122\n" +
-	"\x05color\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x05color\x120\n" +
-	"\x05sound\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x05sound\x12F\n" +
-	"\x10duration_seconds\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fdurationSeconds\"\xf9\x02\n" +
+	"\blocation\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\blocation\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xe8\aR\vdescription\x12=\n" +
+	"\x05color\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueB\t\xfaB\x06r\x04\x10\x01\x18 R\x05color\x120\n" +
+	"\x05sound\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x05sound\x12S\n" +
+	"\x10duration_seconds\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueB\v\xfaB\b\x1a\x06\x18\x80\xa3\x05 \x00R\x0fdurationSeconds\"\xa6\x03\n" +
 	"\x12SightingUpdateInfo\x12;\n" +
 	"\vobserved_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"observedAt\x128\n" +
-	"\blocation\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\blocation\x12>\n" +
-	"\vdescription\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\vdescription\x122\n" +
-	"\x05color\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x05color\x120\n" +
-	"\x05sound\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x05sound\x12F\n" +
-	"\x10duration_seconds\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fdurationSeconds\"\xf9\x01\n" +
+	"observedAt\x12C\n" +
+	"\blocation\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueB\t\xfaB\x06r\x04\x10\x01\x182R\blocation\x12H\n" +
+	"\vdescription\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueB\b\xfaB\x05r\x03\x18\xe8\aR\vdescription\x12=\n" +
+	"\x05color\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueB\t\xfaB\x06r\x04\x10\x01\x18 R\x05color\x120\n" +
+	"\x05sound\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x05sound\x12S\n" +
+	"\x10duration_seconds\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueB\v\xfaB\b\x1a\x06\x18\x80\xa3\x05 \x00R\x0fdurationSeconds\"\xf9\x01\n" +
 	"\bSighting\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12(\n" +
 	"\x04info\x18\x02 \x01(\v2\x14.ufo.v1.SightingInfoR\x04info\x129\n" +
@@ -700,18 +700,18 @@ const file_ufo_v1_ufo_proto_rawDesc = "" +
 	"\x0eGetAllResponse\x12.\n" +
 	"\tsightings\x18\x01 \x03(\v2\x10.ufo.v1.SightingR\tsightings\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\" \n" +
+	"totalCount\"*\n" +
 	"\n" +
-	"GetRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\";\n" +
+	"GetRequest\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\";\n" +
 	"\vGetResponse\x12,\n" +
-	"\bsighting\x18\x01 \x01(\v2\x10.ufo.v1.SightingR\bsighting\"`\n" +
-	"\rUpdateRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12;\n" +
+	"\bsighting\x18\x01 \x01(\v2\x10.ufo.v1.SightingR\bsighting\"j\n" +
+	"\rUpdateRequest\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\x12;\n" +
 	"\vupdate_info\x18\x02 \x01(\v2\x1a.ufo.v1.SightingUpdateInfoR\n" +
-	"updateInfo\"#\n" +
-	"\rDeleteRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid2\xa4\x03\n" +
+	"updateInfo\"-\n" +
+	"\rDeleteRequest\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid2\xa4\x03\n" +
 	"\n" +
 	"UFOService\x12O\n" +
 	"\x06Create\x12\x15.ufo.v1.CreateRequest\x1a\x16.ufo.v1.CreateResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/api/v1/ufo\x12J\n" +
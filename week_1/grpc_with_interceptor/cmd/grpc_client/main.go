@@ -3,45 +3,29 @@ package main
 import (
 	"bufio"
 	"context"
+	"flag"
 	"fmt"
 	"log"
 	"os"
 	"time"
 
-	"github.com/brianvoe/gofakeit"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/clientinterceptor"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/fakedata"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/tlsconf"
 	ufoV1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-const serverAddress = "localhost:50051"
+const (
+	serverAddress  = "localhost:50051"
+	defaultTimeout = 5 * time.Second
+)
 
 func CreateSighting(ctx context.Context, client ufoV1.UFOServiceClient) (string, error) {
-	observedAt := gofakeit.DateRange(
-		time.Now().AddDate(-3, 0, 0), // за последние 3 года
-		time.Now(),
-	)
-	location := gofakeit.City() + ", " + gofakeit.StreetName()
-	description := gofakeit.Sentence(gofakeit.Number(5, 15))
-
-	info := &ufoV1.SightingInfo{
-		ObservedAt:  timestamppb.New(observedAt),
-		Location:    location,
-		Description: description,
-	}
-	if gofakeit.Bool() {
-		info.Color = wrapperspb.String(gofakeit.Color())
-	}
-
-	if gofakeit.Bool() {
-		info.Sound = wrapperspb.Bool(gofakeit.Bool())
-	}
-
-	if gofakeit.Bool() {
-		info.DurationSeconds = wrapperspb.Int32(gofakeit.Int32())
-	}
+	info := fakedata.SightingInfo()
 
 	resp, err := client.Create(ctx, &ufoV1.CreateRequest{Info: info})
 	if err != nil {
@@ -102,8 +86,37 @@ func UpdateSight(ctx context.Context, client ufoV1.UFOServiceClient, uuid string
 }
 
 func main() {
+	var tlsCfg tlsconf.Config
+	flag.StringVar(&tlsCfg.CAFile, "tls-ca", "", "путь к CA для проверки сервера (включает TLS)")
+	flag.StringVar(&tlsCfg.CertFile, "tls-cert", "", "путь к клиентскому сертификату для mTLS")
+	flag.StringVar(&tlsCfg.KeyFile, "tls-key", "", "путь к ключу клиентского сертификата")
+	flag.StringVar(&tlsCfg.ServerName, "tls-server-name", "localhost", "ожидаемое имя сервера в сертификате")
+	flag.Parse()
+
+	creds := insecure.NewCredentials()
+	if tlsCfg.Enabled() {
+		reloader, err := tlsconf.NewReloader(tlsCfg)
+		if err != nil {
+			log.Printf("failed to load TLS config: %v\n", err)
+			return
+		}
+		creds = credentials.NewTLS(reloader.ClientConfig())
+
+		tlsCtx, tlsCancel := context.WithCancel(context.Background())
+		defer tlsCancel()
+		go reloader.Run(tlsCtx)
+	}
+
+	metrics := clientinterceptor.NewMetrics()
+
 	conn, err := grpc.NewClient(serverAddress,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultServiceConfig(clientinterceptor.ServiceConfig),
+		grpc.WithChainUnaryInterceptor(
+			clientinterceptor.LoggerInterceptor(),
+			metrics.Interceptor(),
+			clientinterceptor.TimeoutInterceptor(clientinterceptor.DefaultTimeouts, defaultTimeout),
+		),
 	)
 	if err != nil {
 		log.Printf("failed to connect: %v\n", err)
@@ -114,6 +127,9 @@ func main() {
 			log.Printf("failed to close connect: %v", cerr)
 		}
 	}()
+	defer func() {
+		log.Printf("Статистика вызовов:\n%s", metrics.Report())
+	}()
 
 	client := ufoV1.NewUFOServiceClient(conn)
 	log.Println("=== Тестирование API для работы с наблюдениями НЛО ===")
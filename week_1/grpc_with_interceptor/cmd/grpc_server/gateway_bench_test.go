@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/config"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/gateway"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/interceptor"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/store"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// BenchmarkGatewayGet сравнивает задержку и аллокации REST запроса через
+// gateway при подключении к gRPC по TCP loopback и in-memory (bufconn).
+func BenchmarkGatewayGet(b *testing.B) {
+	for _, upstream := range []string{"tcp", "inprocess"} {
+		b.Run(upstream, func(b *testing.B) {
+			handler, uuid := newBenchGateway(b, upstream)
+			path := "/api/v1/ufo/" + uuid
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				rec := httptest.NewRecorder()
+				handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+				if rec.Code != http.StatusOK {
+					b.Fatalf("unexpected status %d: %s", rec.Code, rec.Body.String())
+				}
+			}
+		})
+	}
+}
+
+func newBenchGateway(b *testing.B, upstream string) (http.Handler, string) {
+	b.Helper()
+
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptor.ValidationInterceptor()))
+	service := NewUfoService(store.New(store.DefaultShards), nil, nil)
+	ufo_v1.RegisterUFOServiceServer(s, service)
+
+	var conn *grpc.ClientConn
+	switch upstream {
+	case "inprocess":
+		lis := gateway.NewInProcessListener()
+		go func() { _ = s.Serve(lis) }()
+
+		c, err := gateway.DialInProcess(lis)
+		if err != nil {
+			b.Fatal(err)
+		}
+		conn = c
+	default:
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			b.Fatal(err)
+		}
+		go func() { _ = s.Serve(lis) }()
+
+		c, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			b.Fatal(err)
+		}
+		conn = c
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	b.Cleanup(func() {
+		cancel()
+		_ = conn.Close()
+		s.Stop()
+	})
+
+	mux, err := newGatewayMux(ctx, config.Default(), conn)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	resp, err := service.Create(ctx, &ufo_v1.CreateRequest{Info: &ufo_v1.SightingInfo{Location: "Roswell"}})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return mux, resp.GetUuid()
+}
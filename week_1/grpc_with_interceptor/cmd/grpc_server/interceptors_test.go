@@ -0,0 +1,241 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/apperr"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/config"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/gateway"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/store"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	ufo_v2 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// TestServerInterceptorsValidationSwitch проверяет, что
+// cfg.Interceptors.Validation = false убирает проверку из цепочки, и
+// невалидный запрос доходит до сервиса.
+func TestServerInterceptorsValidationSwitch(t *testing.T) {
+	tests := []struct {
+		name       string
+		validation bool
+		wantCode   codes.Code
+	}{
+		{name: "enabled", validation: true, wantCode: codes.InvalidArgument},
+		{name: "disabled", validation: false, wantCode: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.Default()
+			cfg.Interceptors.Logging = false
+			cfg.Interceptors.Validation = tt.validation
+
+			client := newInterceptedClient(t, cfg)
+			_, err := client.Create(context.Background(), &ufo_v1.CreateRequest{Info: &ufo_v1.SightingInfo{
+				ObservedAt:      timestamppb.New(time.Now().Add(-time.Hour)),
+				Location:        "Roswell",
+				DurationSeconds: wrapperspb.Int32(-5),
+			}})
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("Create code = %v, want %v (err: %v)", got, tt.wantCode, err)
+			}
+		})
+	}
+}
+
+// newInterceptedClient поднимает in-memory gRPC сервер с цепочками из
+// serverInterceptors(cfg).
+func newInterceptedClient(t *testing.T, cfg config.Config) ufo_v1.UFOServiceClient {
+	t.Helper()
+
+	unary, stream := serverInterceptors(cfg)
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(unary...), grpc.ChainStreamInterceptor(stream...))
+	ufo_v1.RegisterUFOServiceServer(s, NewUfoService(store.New(store.DefaultShards), nil, nil))
+
+	lis := gateway.NewInProcessListener()
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	conn, err := gateway.DialInProcess(lis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return ufo_v1.NewUFOServiceClient(conn)
+}
+
+// TestDeprecationHeadersThroughGateway проверяет заголовки устаревания в
+// ответах REST: у методов наблюдений ufo.v1 — и с успешным ответом, и с
+// ошибкой из gateway.ErrorHandlerWithHeaders, у ufo.v2 и подписок — нет.
+// Заодно проверяется домен ErrorInfo в ошибках каждой версии.
+func TestDeprecationHeadersThroughGateway(t *testing.T) {
+	cfg := config.Default()
+	cfg.Interceptors.Logging = false
+	cfg.API.V1Deprecation = config.DeprecationConfig{Enabled: true, Since: "2026-10-18", Sunset: "2027-04-01"}
+
+	h := newInterceptedGateway(t, cfg)
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		wantStatus     int
+		wantDeprecated bool
+		wantDomain     string
+	}{
+		{
+			name:           "v1 create",
+			method:         http.MethodPost,
+			path:           "/api/v1/ufo",
+			body:           `{"info": {"location": "Roswell"}}`,
+			wantStatus:     http.StatusOK,
+			wantDeprecated: true,
+		},
+		{
+			name:           "v1 not found",
+			method:         http.MethodGet,
+			path:           "/api/v1/ufo/" + missingSightingUUID,
+			wantStatus:     http.StatusNotFound,
+			wantDeprecated: true,
+			wantDomain:     apperr.DomainV1,
+		},
+		{
+			name:           "v1 validation error",
+			method:         http.MethodGet,
+			path:           "/api/v1/ufo/42",
+			wantStatus:     http.StatusBadRequest,
+			wantDeprecated: true,
+			wantDomain:     apperr.DomainV1,
+		},
+		{
+			name:           "v1 stream",
+			method:         http.MethodGet,
+			path:           "/api/v1/ufo:stream",
+			wantStatus:     http.StatusOK,
+			wantDeprecated: true,
+		},
+		{
+			// Webhooks без диспетчера отключены; ошибка подписок тоже без заголовков.
+			name:       "v1 subscriptions",
+			method:     http.MethodGet,
+			path:       "/api/v1/subscriptions",
+			wantStatus: http.StatusNotImplemented,
+		},
+		{
+			name:       "v2 not found",
+			method:     http.MethodGet,
+			path:       "/api/v2/ufo/" + missingSightingUUID,
+			wantStatus: http.StatusNotFound,
+			wantDomain: apperr.DomainV2,
+		},
+		{
+			name:       "v2 invalid update mask",
+			method:     http.MethodPatch,
+			path:       "/api/v2/ufo/" + missingSightingUUID + "?update_mask=create_time",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+			wantDomain: apperr.DomainV2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body)))
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body: %s)", rec.Code, tt.wantStatus, rec.Body)
+			}
+
+			want := map[string]string{"Deprecation": "", "Sunset": "", "Link": ""}
+			if tt.wantDeprecated {
+				want = map[string]string{
+					"Deprecation": "@1792281600",
+					"Sunset":      "Thu, 01 Apr 2027 00:00:00 GMT",
+					"Link":        `</api/v2/ufo>; rel="successor-version"`,
+				}
+			}
+			for name, value := range want {
+				if got := rec.Header().Get(name); got != value {
+					t.Errorf("%s = %q, want %q", name, got, value)
+				}
+			}
+			if got := rec.Header().Get("Grpc-Metadata-Deprecation"); got != "" {
+				t.Errorf("Grpc-Metadata-Deprecation = %q, want the plain header only", got)
+			}
+
+			if tt.wantDomain != "" {
+				if got := errorDomain(t, rec.Body.Bytes()); got != tt.wantDomain {
+					t.Errorf("ErrorInfo domain = %q, want %q", got, tt.wantDomain)
+				}
+			}
+		})
+	}
+}
+
+const missingSightingUUID = "6f1c2b9e-3d4a-4b5c-8e7f-0a1b2c3d4e5f"
+
+// errorDomain достает домен ErrorInfo из тела ошибки gateway.
+func errorDomain(t *testing.T, body []byte) string {
+	t.Helper()
+
+	var resp struct {
+		Details []struct {
+			Type   string `json:"@type"`
+			Domain string `json:"domain"`
+		} `json:"details"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		t.Fatalf("decode error body %s: %v", body, err)
+	}
+	for _, d := range resp.Details {
+		if strings.HasSuffix(d.Type, "google.rpc.ErrorInfo") {
+			return d.Domain
+		}
+	}
+	return ""
+}
+
+// newInterceptedGateway поднимает ufo.v1 и ufo.v2 за цепочками из
+// serverInterceptors(cfg) и возвращает REST mux из newGatewayMux.
+func newInterceptedGateway(t *testing.T, cfg config.Config) http.Handler {
+	t.Helper()
+
+	unary, stream := serverInterceptors(cfg)
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(unary...), grpc.ChainStreamInterceptor(stream...))
+	service := NewUfoService(store.New(store.DefaultShards), nil, nil)
+	ufo_v1.RegisterUFOServiceServer(s, service)
+	ufo_v2.RegisterUFOServiceServer(s, NewUfoServiceV2(service))
+
+	lis := gateway.NewInProcessListener()
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	conn, err := gateway.DialInProcess(lis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	mux, err := newGatewayMux(ctx, cfg, conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mux
+}
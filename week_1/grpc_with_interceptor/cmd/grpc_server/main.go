@@ -2,94 +2,139 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"net"
-	"net/http"
+	"log/slog"
 	"os"
-	"os/signal"
-	"sync"
-	"syscall"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/apperr"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/colors"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/config"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/filter"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/health"
 	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/interceptor"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/lifecycle"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/retention"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/store"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/tlsconf"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/webhook"
 	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	ufo_v2 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v2"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/serverconfig"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-const (
-	grpcPort = 50051
-	httpPort = 8081
-)
-
 type ufoService struct {
 	ufo_v1.UnimplementedUFOServiceServer
 
-	mu       sync.RWMutex
-	sighting map[string]*ufo_v1.Sighting
+	store *store.Store
+	// webhooks доставляет события подписчикам; nil, если webhook выключены.
+	webhooks *webhook.Dispatcher
+	// filters компилирует и кэширует CEL выражения GetAllRequest.filter.
+	filters *filter.Compiler
 }
 
-func NewUfoService() *ufoService {
+func NewUfoService(sightings *store.Store, webhooks *webhook.Dispatcher, filters *filter.Compiler) *ufoService {
 	return &ufoService{
-		sighting: make(map[string]*ufo_v1.Sighting),
+		store:    sightings,
+		webhooks: webhooks,
+		filters:  filters,
 	}
 }
 
+// Ping проверяет доступность хранилища наблюдений.
+func (u *ufoService) Ping(_ context.Context) error {
+	return u.store.Ping()
+}
+
 func (u *ufoService) Create(_ context.Context, rq *ufo_v1.CreateRequest) (*ufo_v1.CreateResponse, error) {
-	if err := rq.Validate(); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	if rq.GetInfo() == nil {
+		return nil, apperr.Validation("info is required")
 	}
-	u.mu.Lock()
-	defer u.mu.Unlock()
 
-	if rq.GetInfo() == nil {
-		return nil, status.Error(codes.InvalidArgument, "info is required")
+	sighting, err := u.create(rq.GetInfo())
+	if err != nil {
+		return nil, err
 	}
+	return &ufo_v1.CreateResponse{
+		Uuid: sighting.GetUuid(),
+	}, nil
+}
 
+// create сохраняет новое наблюдение и оповещает подписчиков. Общая часть
+// Create из ufo.v1 и CreateSighting из ufo.v2.
+func (u *ufoService) create(info *ufo_v1.SightingInfo) (*ufo_v1.Sighting, error) {
 	newUUID := uuid.NewString()
-
 	sighting := &ufo_v1.Sighting{
 		Uuid:      newUUID,
-		Info:      rq.GetInfo(),
+		Info:      info,
 		CreatedAt: timestamppb.New(time.Now()),
 	}
+	// normalized_color вычисляет сервер, значение из запроса не используется.
+	sighting.Info.NormalizedColor = colors.Normalize(sighting.GetInfo().GetColor().GetValue())
 
-	// fmt.Println(sighting)
-	u.sighting[newUUID] = sighting
-	log.Printf("Создано наблюдение с UUID %s", newUUID)
+	if err := u.store.Create(sighting); err != nil {
+		return nil, err
+	}
+	slog.Info("Создано наблюдение", "uuid", newUUID)
+	u.publish(ufo_v1.EventType_EVENT_TYPE_SIGHTING_CREATED, sighting)
 
-	return &ufo_v1.CreateResponse{
-		Uuid: newUUID,
-	}, nil
+	return sighting, nil
 }
 
 func (u *ufoService) Delete(_ context.Context, req *ufo_v1.DeleteRequest) (*emptypb.Empty, error) {
-	u.mu.Lock()
-	defer u.mu.Unlock()
-
-	sighting, ok := u.sighting[req.GetUuid()]
-	if !ok {
-		return nil, status.Errorf(codes.NotFound, "sighting with uuid %s not found", req.GetUuid())
+	if err := u.delete(req.GetUuid()); err != nil {
+		return nil, err
 	}
-	sighting.DeletedAt = timestamppb.New(time.Now())
 	return &emptypb.Empty{}, nil
 }
 
+// delete мягко удаляет наблюдение и оповещает подписчиков.
+func (u *ufoService) delete(id string) error {
+	var deleted *ufo_v1.Sighting
+	err := u.store.Update(id, func(sighting *ufo_v1.Sighting) error {
+		if sighting.DeletedAt != nil {
+			return apperr.AlreadyDeleted(id)
+		}
+		sighting.DeletedAt = timestamppb.New(time.Now())
+		deleted = sighting
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	u.publish(ufo_v1.EventType_EVENT_TYPE_SIGHTING_DELETED, deleted)
+	return nil
+}
+
 func (u *ufoService) GetAll(_ context.Context, req *ufo_v1.GetAllRequest) (*ufo_v1.GetAllResponse, error) {
-	u.mu.RLock()
-	defer u.mu.RUnlock()
+	match, err := u.compileFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
 
-	sightings := make([]*ufo_v1.Sighting, 0, len(u.sighting))
-	for _, s := range u.sighting {
-		sightings = append(sightings, s)
+	sightings := u.store.List()
+	if match != nil {
+		filtered := sightings[:0]
+		for _, s := range sightings {
+			ok, err := match.Match(s)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				filtered = append(filtered, s)
+			}
+		}
+		sightings = filtered
 	}
 	return &ufo_v1.GetAllResponse{
 		Sightings:  sightings,
@@ -97,12 +142,46 @@ func (u *ufoService) GetAll(_ context.Context, req *ufo_v1.GetAllRequest) (*ufo_
 	}, nil
 }
 
+// StreamAll отправляет наблюдения по одному. Список берется из хранилища
+// заранее, поэтому медленный клиент не держит блокировки.
+func (u *ufoService) StreamAll(req *ufo_v1.GetAllRequest, stream grpc.ServerStreamingServer[ufo_v1.Sighting]) error {
+	match, err := u.compileFilter(req.GetFilter())
+	if err != nil {
+		return err
+	}
+
+	for _, s := range u.store.List() {
+		if match != nil {
+			ok, err := match.Match(s)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+		}
+		if err := stream.Send(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compileFilter возвращает программу фильтра или nil для пустого выражения.
+func (u *ufoService) compileFilter(expr string) (*filter.Program, error) {
+	if expr == "" {
+		return nil, nil
+	}
+	if u.filters == nil {
+		return nil, status.Error(codes.Unimplemented, "filter is not supported")
+	}
+	return u.filters.Compile(expr)
+}
+
 func (u *ufoService) Get(ctx context.Context, req *ufo_v1.GetRequest) (*ufo_v1.GetResponse, error) {
-	u.mu.RLock()
-	defer u.mu.RUnlock()
-	sighting, ok := u.sighting[req.GetUuid()]
+	sighting, ok := u.store.Get(req.GetUuid())
 	if !ok {
-		return nil, status.Errorf(codes.NotFound, "sighting with UUID %s not found", req.GetUuid())
+		return nil, apperr.NotFound(req.GetUuid())
 	}
 	return &ufo_v1.GetResponse{
 		Sighting: sighting,
@@ -110,116 +189,181 @@ func (u *ufoService) Get(ctx context.Context, req *ufo_v1.GetRequest) (*ufo_v1.G
 }
 
 func (u *ufoService) Update(_ context.Context, req *ufo_v1.UpdateRequest) (*emptypb.Empty, error) {
-	u.mu.Lock()
-	defer u.mu.Unlock()
+	if req.GetUpdateInfo() == nil {
+		return nil, apperr.Validation("update_info is required")
+	}
+	// Копия не дает новой версии наблюдения разделять вложенные сообщения с запросом.
+	update := proto.CloneOf(req.GetUpdateInfo())
 
-	sighting, ok := u.sighting[req.GetUuid()]
-	if !ok {
-		return nil, status.Errorf(codes.NotFound, "sighting with uuid %s not found", req.GetUuid())
+	var updated *ufo_v1.Sighting
+	err := u.store.Update(req.GetUuid(), func(sighting *ufo_v1.Sighting) error {
+		if sighting.DeletedAt != nil {
+			return apperr.AlreadyDeleted(req.GetUuid())
+		}
+
+		if update.ObservedAt != nil {
+			sighting.Info.ObservedAt = update.ObservedAt
+		}
+
+		if update.Location != nil {
+			sighting.Info.Location = update.Location.Value
+		}
+
+		if update.Description != nil {
+			sighting.Info.Description = update.Description.Value
+		}
+
+		if update.Color != nil {
+			sighting.Info.Color = update.Color
+			sighting.Info.NormalizedColor = colors.Normalize(update.Color.GetValue())
+		}
+
+		if update.Sound != nil {
+			sighting.Info.Sound = update.Sound
+		}
+
+		if update.DurationSeconds != nil {
+			sighting.Info.DurationSeconds = update.DurationSeconds
+		}
+
+		sighting.UpdatedAt = timestamppb.New(time.Now())
+		updated = sighting
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	u.publish(ufo_v1.EventType_EVENT_TYPE_SIGHTING_UPDATED, updated)
 
-	update := req.GetUpdateInfo()
-	if update == nil {
-		return nil, status.Error(codes.InvalidArgument, "update_info is required")
+	return &emptypb.Empty{}, nil
+}
+
+func main() {
+	cfg, err := config.Load(os.Args[0], os.Args[1:])
+	if err != nil {
+		log.Fatalf("failed to load config: %v\n", err)
 	}
+	serverconfig.SetupLogger(cfg.Log)
+	slog.Info("⚙️ Effective config", "config", cfg.String())
 
-	if req.GetUpdateInfo().ObservedAt != nil {
-		sighting.Info.ObservedAt = req.GetUpdateInfo().ObservedAt
+	unary, stream := serverInterceptors(cfg)
+	serverOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
 	}
+	dialCreds := insecure.NewCredentials()
 
-	if req.GetUpdateInfo().Location != nil {
-		sighting.Info.Location = req.GetUpdateInfo().Location.Value
+	var reloader *tlsconf.Reloader
+	if tlsCfg := cfg.TLS.Tlsconf(); tlsCfg.Enabled() {
+		reloader, err = tlsconf.NewReloader(tlsCfg)
+		if err != nil {
+			log.Fatalf("failed to load TLS config: %v\n", err)
+		}
+
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(reloader.ServerConfig(reloader.ClientAuthType(), "h2"))))
+
+		// Gateway ходит в gRPC как клиент: проверяет сервер по CA и при mTLS
+		// предъявляет тот же сертификат, что и сервер.
+		dialCreds = credentials.NewTLS(reloader.ClientConfig())
 	}
 
-	if req.GetUpdateInfo().Description != nil {
-		sighting.Info.Description = req.GetUpdateInfo().Description.Value
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sightings, closeStore, err := newStore(ctx, cfg.Storage)
+	if err != nil {
+		log.Fatalf("failed to init storage: %v\n", err)
 	}
 
-	if req.GetUpdateInfo().Color != nil {
-		sighting.Info.Color = req.GetUpdateInfo().Color
+	var webhooks *webhook.Dispatcher
+	if cfg.Webhooks.Enabled {
+		webhooks = webhook.New(cfg.Webhooks.Webhook())
+		webhooks.Start()
 	}
 
-	if req.GetUpdateInfo().Sound != nil {
-		sighting.Info.Sound = req.GetUpdateInfo().Sound
+	s := grpc.NewServer(serverOpts...)
+	filters, err := filter.NewCompiler(cfg.Filter.Filter())
+	if err != nil {
+		log.Fatalf("failed to init filter compiler: %v\n", err)
 	}
 
-	if req.GetUpdateInfo().DurationSeconds != nil {
-		sighting.Info.DurationSeconds = req.GetUpdateInfo().DurationSeconds
+	service := NewUfoService(sightings, webhooks, filters)
+
+	ufo_v1.RegisterUFOServiceServer(s, service)
+	services := []string{ufo_v1.UFOService_ServiceDesc.ServiceName}
+	if cfg.API.V2 {
+		ufo_v2.RegisterUFOServiceServer(s, NewUfoServiceV2(service))
+		services = append(services, ufo_v2.UFOService_ServiceDesc.ServiceName)
 	}
 
-	sighting.UpdatedAt = timestamppb.New(time.Now())
+	healthService := health.NewService(service.Ping, services...)
+	healthService.Register(s)
 
-	return &emptypb.Empty{}, nil
-}
+	reflection.Register(s)
 
-func main() {
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
+	go healthService.Run(ctx, cfg.Health.CheckInterval)
+	if reloader != nil {
+		go reloader.Run(ctx)
+	}
+
+	lc := lifecycle.New()
+
+	// Порядок остановки: сначала снимаем готовность и ждем health.shutdown_delay,
+	// чтобы балансировщик перестал присылать запросы, затем HTTP серверы, затем gRPC, затем фоновые задачи (очистка, доставка webhook), затем хранилище.
+	lc.OnShutdown("readiness", cfg.Health.ShutdownDelay+time.Second, lifecycle.Unready(healthService.Shutdown, cfg.Health.ShutdownDelay))
+
+	if cfg.Mode == config.ModeSingle {
+		err = setupSinglePort(ctx, lc, cfg, s, dialCreds, reloader)
+	} else {
+		err = setupSplitPorts(ctx, lc, cfg, s, dialCreds, reloader)
+	}
 	if err != nil {
-		log.Printf("failed to listen: %v\n", err)
-		return
+		log.Fatalf("failed to start servers: %v\n", err)
 	}
 
-	defer func() {
-		if cerr := lis.Close(); cerr != nil {
-			log.Printf("failed to close listener: %v\n", cerr)
-		}
-	}()
-	s := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			grpc.UnaryServerInterceptor(interceptor.LoggerInterceptor()),
-			grpc.UnaryServerInterceptor(interceptor.LoggerInterceptor2()),
-		),
-	)
-	service := &ufoService{
-		sighting: make(map[string]*ufo_v1.Sighting),
+	if cfg.Retention.Enabled {
+		job := retention.New(sightings, cfg.Retention.Retention())
+		job.Start()
+		lc.OnShutdown("retention", cfg.Retention.ShutdownTimeout, job.Shutdown)
 	}
 
-	ufo_v1.RegisterUFOServiceServer(s, service)
+	// После остановки gRPC новых событий нет: доставляем то, что осталось в очереди.
+	if webhooks != nil {
+		lc.OnShutdown("webhooks", cfg.Webhooks.ShutdownTimeout, webhooks.Shutdown)
+	}
 
-	reflection.Register(s)
+	// Снимок хранилища пишется последним, когда gRPC уже не принимает запросы.
+	lc.OnShutdown("storage", cfg.Storage.ShutdownTimeout, closeStore)
 
-	go func() {
-		log.Printf("🚀 gRPC server listening on %d\n", grpcPort)
-		err = s.Serve(lis)
-		if err != nil {
-			log.Printf("failed to serve: %v\n", err)
-			return
-		}
-	}()
-
-	var gwServer *http.Server
-
-	go func() {
-		ctx, cancel := context.WithCancel(context.Background())
-		defer cancel()
-		mux := runtime.NewServeMux()
-		opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
-		err = ufo_v1.RegisterUFOServiceHandlerFromEndpoint(
-			ctx, mux, fmt.Sprintf("localhost:%d", grpcPort),
-			opts,
-		)
-		if err != nil {
-			log.Printf("Failed to register gateway: %v\n", err)
-			return
-		}
-		gwServer = &http.Server{
-			Addr:        fmt.Sprintf(":%d", httpPort),
-			Handler:     mux,
-			ReadTimeout: 10 * time.Second,
-		}
-		log.Printf("🌐 HTTP server with gRPC-Gateway listening on %d\n", httpPort)
-		err = gwServer.ListenAndServe()
-		if err != nil && err != http.ErrServerClosed {
-			log.Printf("Failed to serve HTTP: %v\n", err)
-			return
-		}
-	}()
-
-	// Graceful shutdown
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
-	log.Println("🛑 Shutting down gRPC server...")
-	s.GracefulStop()
-	log.Println("✅ Server stopped")
+	if err := lc.Run(ctx); err != nil {
+		slog.Error("❌ Server stopped with error", "error", err)
+		os.Exit(1)
+	}
+	slog.Info("✅ Server stopped")
+}
+
+// serverInterceptors собирает цепочки интерцепторов gRPC сервера по настройкам
+// из cfg.Interceptors и cfg.API.
+func serverInterceptors(cfg config.Config) ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor) {
+	var unary []grpc.UnaryServerInterceptor
+	var stream []grpc.StreamServerInterceptor
+
+	unary = append(unary, interceptor.IdentityInterceptor(), interceptor.ErrorDomainInterceptor())
+	stream = append(stream, interceptor.IdentityStreamInterceptor(), interceptor.ErrorDomainStreamInterceptor())
+	if cfg.API.V1Deprecation.Enabled {
+		// Раньше валидации: заголовки нужны и в ответах с ошибкой.
+		deprecation := cfg.API.V1Deprecation.Deprecation(deprecatedV1Methods, successorV2)
+		unary = append(unary, interceptor.DeprecationInterceptor(deprecation))
+		stream = append(stream, interceptor.DeprecationStreamInterceptor(deprecation))
+	}
+	if cfg.Interceptors.Logging {
+		unary = append(unary, interceptor.LoggerInterceptor(), interceptor.LoggerInterceptor2())
+		stream = append(stream, interceptor.LoggerStreamInterceptor(), interceptor.LoggerStreamInterceptor2())
+	}
+	if cfg.Interceptors.Validation {
+		unary = append(unary, interceptor.ValidationInterceptor(observedAtNotInFuture(time.Now)))
+		stream = append(stream, interceptor.ValidationStreamInterceptor(observedAtNotInFuture(time.Now)))
+	}
+
+	return unary, stream
 }
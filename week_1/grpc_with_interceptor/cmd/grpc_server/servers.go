@@ -0,0 +1,309 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/admin"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/config"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/docs"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/gateway"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/interceptor"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/lifecycle"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/middleware"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/tlsconf"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	ufo_v2 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// setupSplitPorts запускает gRPC, gateway и admin каждый на своем порту.
+func setupSplitPorts(
+	ctx context.Context,
+	lc *lifecycle.Manager,
+	cfg config.Config,
+	s *grpc.Server,
+	dialCreds credentials.TransportCredentials,
+	reloader *tlsconf.Reloader,
+) error {
+	lis, err := net.Listen("tcp", cfg.GRPC.Address())
+	if err != nil {
+		return fmt.Errorf("listen grpc: %w", err)
+	}
+
+	slog.Info("🚀 gRPC server listening", "addr", cfg.GRPC.Address())
+	lc.Go("grpc", lifecycle.ServeGRPC(s, lis))
+
+	drain := lifecycle.NewHTTPDrain()
+	if cfg.Gateway.Enabled {
+		conn, err := dialUpstream(ctx, lc, cfg, s, dialCreds)
+		if err != nil {
+			return err
+		}
+		handler, err := newGatewayHandler(ctx, cfg, s, conn, drain)
+		if err != nil {
+			return err
+		}
+
+		gwServer := newHTTPServer(cfg, handler)
+		if reloader != nil {
+			gwServer.TLSConfig = reloader.ServerConfig(tls.NoClientCert, "h2", "http/1.1")
+		}
+
+		gwLis, err := net.Listen("tcp", cfg.Gateway.Address())
+		if err != nil {
+			return fmt.Errorf("listen gateway: %w", err)
+		}
+
+		if gwServer.TLSConfig != nil {
+			slog.Info("🌐 HTTPS server with gRPC-Gateway listening", "addr", cfg.Gateway.Address())
+		} else {
+			slog.Info("🌐 HTTP server with gRPC-Gateway listening", "addr", cfg.Gateway.Address())
+		}
+		lc.Go("gateway", lifecycle.ServeHTTP(gwServer, gwLis))
+		lc.OnShutdown("gateway", cfg.Gateway.ShutdownTimeout, lifecycle.ShutdownHTTP(gwServer))
+	}
+
+	lc.OnShutdown("grpc", cfg.GRPC.ShutdownTimeout, lifecycle.StopGRPC(s, drain))
+
+	return setupAdmin(lc, cfg)
+}
+
+// setupSinglePort запускает gRPC и gateway на одном порту grpc.port, admin —
+// на своем admin.port.
+func setupSinglePort(
+	ctx context.Context,
+	lc *lifecycle.Manager,
+	cfg config.Config,
+	s *grpc.Server,
+	dialCreds credentials.TransportCredentials,
+	reloader *tlsconf.Reloader,
+) error {
+	drain := lifecycle.NewHTTPDrain()
+	var rest http.Handler = http.NotFoundHandler()
+	if cfg.Gateway.Enabled {
+		conn, err := dialUpstream(ctx, lc, cfg, s, dialCreds)
+		if err != nil {
+			return err
+		}
+		handler, err := newGatewayHandler(ctx, cfg, s, conn, drain)
+		if err != nil {
+			return err
+		}
+		rest = handler
+	}
+
+	// Нативный gRPC здесь тоже идет через ServeHTTP и учитывается в drain.
+	srv := newHTTPServer(cfg, gateway.SinglePortHandler(drain.Wrap(s), rest, reloader == nil, cfg.TLS.ClientAuth))
+	// Таймауты чтения и записи всего запроса оборвали бы долгие gRPC потоки.
+	srv.ReadTimeout = 0
+	srv.WriteTimeout = 0
+	if reloader != nil {
+		clientAuth := tls.NoClientCert
+		if cfg.TLS.ClientAuth {
+			clientAuth = tls.VerifyClientCertIfGiven
+		}
+		srv.TLSConfig = reloader.ServerConfig(clientAuth, "h2", "http/1.1")
+	}
+
+	lis, err := net.Listen("tcp", cfg.GRPC.Address())
+	if err != nil {
+		return fmt.Errorf("listen: %w", err)
+	}
+
+	slog.Info("🚀 gRPC and gateway listening on single port", "addr", cfg.GRPC.Address())
+	lc.Go("server", lifecycle.ServeHTTP(srv, lis))
+	lc.OnShutdown("server", cfg.Gateway.ShutdownTimeout, lifecycle.ShutdownHTTP(srv))
+	lc.OnShutdown("grpc", cfg.GRPC.ShutdownTimeout, lifecycle.StopGRPC(s, drain))
+
+	return setupAdmin(lc, cfg)
+}
+
+// setupAdmin запускает admin сервер на отдельном порту admin.port в обоих
+// режимах: /debug/ не должен быть доступен на публичных портах.
+func setupAdmin(lc *lifecycle.Manager, cfg config.Config) error {
+	if !cfg.Admin.Enabled {
+		return nil
+	}
+
+	lis, err := net.Listen("tcp", cfg.Admin.Address())
+	if err != nil {
+		return fmt.Errorf("listen admin: %w", err)
+	}
+	srv := &http.Server{
+		Handler:           admin.NewHandler(cfg),
+		ReadHeaderTimeout: cfg.Gateway.ReadHeaderTimeout,
+	}
+
+	slog.Info("🛠️ Admin server listening", "addr", cfg.Admin.Address())
+	lc.Go("admin", lifecycle.ServeHTTP(srv, lis))
+	lc.OnShutdown("admin", cfg.Admin.ShutdownTimeout, lifecycle.ShutdownHTTP(srv))
+
+	return nil
+}
+
+// dialUpstream создает соединение gateway с gRPC сервером: in-memory через
+// bufconn или по сети через grpc.port. В обоих случаях используются dialCreds:
+// сервер s один и тот же, и при включенном TLS рукопожатие идет и по bufconn.
+// Соединение закрывается вместе с ctx.
+func dialUpstream(
+	ctx context.Context,
+	lc *lifecycle.Manager,
+	cfg config.Config,
+	s *grpc.Server,
+	dialCreds credentials.TransportCredentials,
+) (*grpc.ClientConn, error) {
+	var (
+		conn *grpc.ClientConn
+		err  error
+	)
+
+	if cfg.Gateway.Upstream == config.UpstreamInProcess {
+		lis := gateway.NewInProcessListener()
+		lc.Go("grpc-inprocess", lifecycle.ServeGRPC(s, lis))
+		conn, err = gateway.DialInProcess(lis, grpc.WithTransportCredentials(dialCreds))
+	} else {
+		conn, err = grpc.NewClient(cfg.GRPC.DialAddress(), grpc.WithTransportCredentials(dialCreds))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("dial grpc upstream: %w", err)
+	}
+
+	context.AfterFunc(ctx, func() {
+		if cerr := conn.Close(); cerr != nil {
+			slog.Error("failed to close gateway connection", "error", cerr)
+		}
+	})
+
+	return conn, nil
+}
+
+// newGatewayMux собирает mux grpc-gateway с обработчиком ошибок, health
+// эндпоинтами и документацией API.
+func newGatewayMux(ctx context.Context, cfg config.Config, conn *grpc.ClientConn) (*runtime.ServeMux, error) {
+	// Заголовки устаревания отдаются как есть, а не Grpc-Metadata-Deprecation.
+	outgoing := gateway.OutgoingHeaderMatcher(interceptor.DeprecationHeaders)
+	opts := []runtime.ServeMuxOption{
+		runtime.WithErrorHandler(gateway.ErrorHandlerWithHeaders(outgoing)),
+		runtime.WithIncomingHeaderMatcher(gateway.HeaderMatcher(cfg.Gateway.ForwardHeaders)),
+		runtime.WithOutgoingHeaderMatcher(outgoing),
+	}
+	opts = append(opts, gateway.MarshalerOptions(gateway.JSONOptions{
+		EmitUnpopulated: cfg.Gateway.JSON.EmitUnpopulated,
+		UseProtoNames:   cfg.Gateway.JSON.UseProtoNames,
+		UseEnumNumbers:  cfg.Gateway.JSON.UseEnumNumbers,
+	})...)
+	mux := runtime.NewServeMux(opts...)
+
+	if err := ufo_v1.RegisterUFOServiceHandler(ctx, mux, conn); err != nil {
+		return nil, fmt.Errorf("register gateway: %w", err)
+	}
+	if cfg.API.V2 {
+		if err := ufo_v2.RegisterUFOServiceHandler(ctx, mux, conn); err != nil {
+			return nil, fmt.Errorf("register gateway v2: %w", err)
+		}
+	}
+
+	err := gateway.RegisterHealthHandlers(mux, healthpb.NewHealthClient(conn), ufo_v1.UFOService_ServiceDesc.ServiceName)
+	if err != nil {
+		return nil, fmt.Errorf("register health handlers: %w", err)
+	}
+
+	if err := docs.RegisterHandlers(mux, cfg.API.V2); err != nil {
+		return nil, fmt.Errorf("register docs handlers: %w", err)
+	}
+
+	return mux, nil
+}
+
+// newGatewayHandler собирает HTTP обработчик gateway: REST mux, при
+// включенном gateway.web_rpc — gRPC-Web и Connect, и цепочку middleware.
+// Вызовы gRPC-Web и Connect идут в s через ServeHTTP и учитываются в drain.
+func newGatewayHandler(ctx context.Context, cfg config.Config, s *grpc.Server, conn *grpc.ClientConn, drain *lifecycle.HTTPDrain) (http.Handler, error) {
+	mux, err := newGatewayMux(ctx, cfg, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	var handler http.Handler = mux
+	if cfg.Gateway.WebRPC {
+		handler, err = gateway.WebRPCHandler(s, mux, drain)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return gatewayMiddleware(cfg.Gateway, cfg.API.V1Deprecation.Enabled, mux, handler), nil
+}
+
+// gatewayMiddleware оборачивает gateway цепочкой HTTP middleware из конфигурации.
+// Request ID выставляется всегда: gateway передает его в gRPC метаданные.
+// deprecation открывает браузерам заголовки устаревания через CORS. Ошибки
+// middleware кодируются маршалерами mux, как и ответы gateway.
+func gatewayMiddleware(gw config.GatewayConfig, deprecation bool, mux *runtime.ServeMux, handler http.Handler) http.Handler {
+	cfg := gw.Middleware
+
+	chain := []middleware.Middleware{middleware.RequestID}
+	if cfg.AccessLog {
+		chain = append(chain, middleware.AccessLog)
+	}
+	if cfg.Recovery {
+		chain = append(chain, middleware.Recoverer(mux))
+	}
+	if len(cfg.CORS.AllowedOrigins) > 0 {
+		cors := middleware.CORSOptions{
+			AllowedOrigins:   cfg.CORS.AllowedOrigins,
+			AllowedMethods:   cfg.CORS.AllowedMethods,
+			AllowedHeaders:   cfg.CORS.AllowedHeaders,
+			ExposedHeaders:   cfg.CORS.ExposedHeaders,
+			AllowCredentials: cfg.CORS.AllowCredentials,
+			MaxAge:           cfg.CORS.MaxAge,
+		}
+		if gw.WebRPC {
+			cors.AllowedHeaders = mergeHeaders(cors.AllowedHeaders, gateway.WebRPCAllowedHeaders)
+			cors.ExposedHeaders = mergeHeaders(cors.ExposedHeaders, gateway.WebRPCExposedHeaders)
+		}
+		if deprecation {
+			cors.ExposedHeaders = mergeHeaders(cors.ExposedHeaders, interceptor.DeprecationHeaders)
+		}
+		chain = append(chain, middleware.CORS(cors))
+	}
+	if cfg.MaxBodyBytes > 0 {
+		chain = append(chain, middleware.BodyLimit(mux, cfg.MaxBodyBytes))
+	}
+	if cfg.Compression {
+		chain = append(chain, middleware.Compress)
+	}
+
+	return middleware.Chain(handler, chain...)
+}
+
+// mergeHeaders объединяет списки заголовков без повторов.
+func mergeHeaders(headers, extra []string) []string {
+	result := slices.Clone(headers)
+	for _, h := range extra {
+		if !slices.ContainsFunc(result, func(v string) bool { return strings.EqualFold(v, h) }) {
+			result = append(result, h)
+		}
+	}
+	return result
+}
+
+func newHTTPServer(cfg config.Config, handler http.Handler) *http.Server {
+	return &http.Server{
+		Handler:           handler,
+		ReadTimeout:       cfg.Gateway.ReadTimeout,
+		ReadHeaderTimeout: cfg.Gateway.ReadHeaderTimeout,
+		WriteTimeout:      cfg.Gateway.WriteTimeout,
+		IdleTimeout:       cfg.Gateway.IdleTimeout,
+	}
+}
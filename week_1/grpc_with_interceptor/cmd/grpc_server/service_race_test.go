@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/apperr"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/store"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// TestServiceConcurrentUpdateAndRead запускается с -race: ответы Get, GetAll
+// и StreamAll сериализуются так же, как это делает gRPC, пока Update и Delete
+// меняют те же наблюдения.
+func TestServiceConcurrentUpdateAndRead(t *testing.T) {
+	ctx := context.Background()
+	service := NewUfoService(store.New(4), nil, nil)
+
+	ids := make([]string, 8)
+	for i := range ids {
+		info := &ufo_v1.SightingInfo{Location: "Roswell"}
+		resp, err := service.Create(ctx, &ufo_v1.CreateRequest{Info: info})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Запрос остается у вызывающего кода и может меняться после Create.
+		info.Location = "changed after create"
+		ids[i] = resp.GetUuid()
+	}
+
+	const iterations = 300
+	var wg sync.WaitGroup
+
+	for w := range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range iterations {
+				_, err := service.Update(ctx, &ufo_v1.UpdateRequest{
+					Uuid: ids[(w+i)%len(ids)],
+					UpdateInfo: &ufo_v1.SightingUpdateInfo{
+						Description:     wrapperspb.String("updated"),
+						DurationSeconds: wrapperspb.Int32(int32(i)),
+					},
+				})
+				if err != nil && !errors.Is(err, apperr.ErrAlreadyDeleted) {
+					t.Error(err)
+					return
+				}
+				runtime.Gosched()
+			}
+		}()
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for _, id := range ids[len(ids)/2:] {
+			if _, err := service.Delete(ctx, &ufo_v1.DeleteRequest{Uuid: id}); err != nil {
+				t.Error(err)
+			}
+			runtime.Gosched()
+		}
+	}()
+
+	for r := range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range iterations {
+				var msg proto.Message
+				if i%5 == 0 {
+					resp, err := service.GetAll(ctx, &ufo_v1.GetAllRequest{})
+					if err != nil {
+						t.Error(err)
+						return
+					}
+					msg = resp
+				} else {
+					resp, err := service.Get(ctx, &ufo_v1.GetRequest{Uuid: ids[(r+i)%len(ids)]})
+					if err != nil {
+						t.Error(err)
+						return
+					}
+					msg = resp
+				}
+				if _, err := proto.Marshal(msg); err != nil {
+					t.Error(err)
+					return
+				}
+				runtime.Gosched()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	for _, id := range ids {
+		resp, err := service.Get(ctx, &ufo_v1.GetRequest{Uuid: id})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := resp.GetSighting().GetInfo().GetLocation(); got != "Roswell" {
+			t.Fatalf("sighting %s location = %q, want %q", id, got, "Roswell")
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/apperr"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/colors"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/convert"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	ufo_v2 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v2"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// mutableFieldsV2 поля ufo.v2.Sighting верхнего уровня, которые можно менять
+// через UpdateSighting. Остальные задает сервер.
+var mutableFieldsV2 = []string{"observed_at", "location", "description", "color_text", "sound", "duration"}
+
+// deprecatedV1Methods методы ufo.v1, которые заменяет ufo.v2.UFOService.
+// Подписки и доставки webhook есть только в v1 и не устарели.
+var deprecatedV1Methods = []string{
+	ufo_v1.UFOService_Create_FullMethodName,
+	ufo_v1.UFOService_Get_FullMethodName,
+	ufo_v1.UFOService_Update_FullMethodName,
+	ufo_v1.UFOService_Delete_FullMethodName,
+	ufo_v1.UFOService_GetAll_FullMethodName,
+	ufo_v1.UFOService_StreamAll_FullMethodName,
+}
+
+// successorV2 путь REST ресурса, который заменяет deprecatedV1Methods.
+const successorV2 = "/api/v2/ufo"
+
+// ufoServiceV2 реализует ufo.v2.UFOService поверх того же хранилища, что и
+// ufo.v1: наблюдения переводятся между версиями пакетом convert.
+type ufoServiceV2 struct {
+	ufo_v2.UnimplementedUFOServiceServer
+
+	v1 *ufoService
+}
+
+func NewUfoServiceV2(v1 *ufoService) *ufoServiceV2 {
+	return &ufoServiceV2{v1: v1}
+}
+
+func (u *ufoServiceV2) CreateSighting(_ context.Context, req *ufo_v2.CreateSightingRequest) (*ufo_v2.Sighting, error) {
+	if err := requireLocation(req.GetSighting()); err != nil {
+		return nil, err
+	}
+	in, err := convert.SightingFromV2(req.GetSighting())
+	if err != nil {
+		return nil, err
+	}
+
+	sighting, err := u.v1.create(in.GetInfo())
+	if err != nil {
+		return nil, err
+	}
+	return convert.SightingToV2(sighting), nil
+}
+
+func (u *ufoServiceV2) GetSighting(_ context.Context, req *ufo_v2.GetSightingRequest) (*ufo_v2.Sighting, error) {
+	sighting, ok := u.v1.store.Get(req.GetUuid())
+	if !ok {
+		return nil, apperr.NotFound(req.GetUuid())
+	}
+	return convert.SightingToV2(sighting), nil
+}
+
+// UpdateSighting применяет к текущей версии наблюдения поля из update_mask.
+// Наблюдение переводится в v2, изменяется и переводится обратно, поэтому
+// маска работает и для полей, которых нет в ufo.v1.SightingUpdateInfo.
+func (u *ufoServiceV2) UpdateSighting(_ context.Context, req *ufo_v2.UpdateSightingRequest) (*ufo_v2.Sighting, error) {
+	id := req.GetSighting().GetUuid()
+	if err := uuid.Validate(id); err != nil {
+		return nil, apperr.Validation("invalid sighting uuid", &errdetails.BadRequest_FieldViolation{
+			Field:       "sighting.uuid",
+			Description: "value must be a valid UUID",
+		})
+	}
+	paths, err := updatePaths(req.GetUpdateMask())
+	if err != nil {
+		return nil, err
+	}
+
+	var updated *ufo_v1.Sighting
+	err = u.v1.store.Update(id, func(sighting *ufo_v1.Sighting) error {
+		if sighting.DeletedAt != nil {
+			return apperr.AlreadyDeleted(id)
+		}
+
+		current := convert.SightingToV2(sighting)
+		for _, path := range paths {
+			copyField(current.ProtoReflect(), req.GetSighting().ProtoReflect(), strings.Split(path, "."))
+		}
+		if err := requireLocation(current); err != nil {
+			return err
+		}
+		next, err := convert.SightingFromV2(current)
+		if err != nil {
+			return err
+		}
+
+		sighting.Info = next.GetInfo()
+		sighting.Info.NormalizedColor = colors.Normalize(sighting.GetInfo().GetColor().GetValue())
+		sighting.UpdatedAt = timestamppb.New(time.Now())
+		updated = sighting
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.v1.publish(ufo_v1.EventType_EVENT_TYPE_SIGHTING_UPDATED, updated)
+
+	return convert.SightingToV2(updated), nil
+}
+
+func (u *ufoServiceV2) DeleteSighting(_ context.Context, req *ufo_v2.DeleteSightingRequest) (*emptypb.Empty, error) {
+	if err := u.v1.delete(req.GetUuid()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (u *ufoServiceV2) ListSightings(_ context.Context, req *ufo_v2.ListSightingsRequest) (*ufo_v2.ListSightingsResponse, error) {
+	var sightings []*ufo_v2.Sighting
+	for _, s := range u.v1.store.List() {
+		if s.GetDeletedAt() != nil && !req.GetShowDeleted() {
+			continue
+		}
+		sightings = append(sightings, convert.SightingToV2(s))
+	}
+	return &ufo_v2.ListSightingsResponse{
+		Sightings:  sightings,
+		TotalCount: int32(len(sightings)),
+	}, nil
+}
+
+// StreamSightings отправляет наблюдения по одному, как StreamAll в ufo.v1.
+func (u *ufoServiceV2) StreamSightings(req *ufo_v2.ListSightingsRequest, stream grpc.ServerStreamingServer[ufo_v2.Sighting]) error {
+	for _, s := range u.v1.store.List() {
+		if s.GetDeletedAt() != nil && !req.GetShowDeleted() {
+			continue
+		}
+		if err := stream.Send(convert.SightingToV2(s)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// requireLocation проверяет обязательное название места. В proto правило не
+// задано: при частичном обновлении location может содержать только coordinates.
+func requireLocation(s *ufo_v2.Sighting) error {
+	if s.GetLocation().GetName() != "" {
+		return nil
+	}
+	return apperr.Validation("sighting location is required", &errdetails.BadRequest_FieldViolation{
+		Field:       "sighting.location.name",
+		Description: "value is required",
+	})
+}
+
+// updatePaths проверяет пути update_mask. Пустая маска и "*" означают все
+// изменяемые поля; вложенные пути (location.name, location.coordinates.latitude)
+// разрешены внутри изменяемых полей.
+func updatePaths(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	paths := mask.GetPaths()
+	if len(paths) == 0 || (len(paths) == 1 && paths[0] == "*") {
+		return mutableFieldsV2, nil
+	}
+
+	desc := (&ufo_v2.Sighting{}).ProtoReflect().Descriptor()
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, path := range paths {
+		if err := checkPath(desc, path); err != nil {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       "update_mask",
+				Description: err.Error(),
+			})
+		}
+	}
+	if len(violations) > 0 {
+		return nil, apperr.Validation("invalid update_mask", violations...)
+	}
+	return paths, nil
+}
+
+func checkPath(desc protoreflect.MessageDescriptor, path string) error {
+	names := strings.Split(path, ".")
+	if !slices.Contains(mutableFieldsV2, names[0]) {
+		if desc.Fields().ByName(protoreflect.Name(names[0])) != nil {
+			return fmt.Errorf("field %q is output only", path)
+		}
+		return fmt.Errorf("unknown field %q", path)
+	}
+	for i, name := range names {
+		fd := desc.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return fmt.Errorf("unknown field %q", path)
+		}
+		if i == len(names)-1 {
+			return nil
+		}
+		if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+			return fmt.Errorf("field %q has no subfields", strings.Join(names[:i+1], "."))
+		}
+		desc = fd.Message()
+	}
+	return nil
+}
+
+// copyField переносит значение поля по пути из src в dst. Отсутствующее в
+// src поле очищается в dst. Путь должен быть проверен checkPath.
+func copyField(dst, src protoreflect.Message, path []string) {
+	fd := dst.Descriptor().Fields().ByName(protoreflect.Name(path[0]))
+	if len(path) > 1 {
+		copyField(dst.Mutable(fd).Message(), src.Get(fd).Message(), path[1:])
+		return
+	}
+	if src.Has(fd) {
+		dst.Set(fd, src.Get(fd))
+	} else {
+		dst.Clear(fd)
+	}
+}
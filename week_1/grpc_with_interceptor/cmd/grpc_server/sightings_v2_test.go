@@ -0,0 +1,308 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/store"
+	ufo_v2 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v2"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// baseSightingV2 наблюдение со всеми изменяемыми полями.
+func baseSightingV2() *ufo_v2.Sighting {
+	return &ufo_v2.Sighting{
+		ObservedAt: timestamppb.New(time.Date(2026, 7, 2, 22, 0, 0, 0, time.UTC)),
+		Location: &ufo_v2.Location{
+			Name:        "Roswell",
+			Coordinates: &ufo_v2.Coordinates{Latitude: 33.39, Longitude: -104.52},
+		},
+		Description: "green lights",
+		ColorText:   proto.String("green"),
+		Sound:       ufo_v2.Sound_SOUND_PRESENT,
+		Duration:    durationpb.New(time.Minute),
+	}
+}
+
+func TestUpdateSightingMask(t *testing.T) {
+	tests := []struct {
+		name   string
+		paths  []string
+		update *ufo_v2.Sighting
+		// want изменяет ожидаемое наблюдение относительно baseSightingV2.
+		want     func(s *ufo_v2.Sighting)
+		wantCode codes.Code
+		wantMsg  string
+	}{
+		{
+			name:   "top-level field",
+			paths:  []string{"description"},
+			update: &ufo_v2.Sighting{Description: "silent disc", Sound: ufo_v2.Sound_SOUND_ABSENT},
+			want:   func(s *ufo_v2.Sighting) { s.Description = "silent disc" },
+		},
+		{
+			name:   "nested field",
+			paths:  []string{"location.coordinates.latitude"},
+			update: &ufo_v2.Sighting{Location: &ufo_v2.Location{Name: "ignored", Coordinates: &ufo_v2.Coordinates{Latitude: 10, Longitude: 20}}},
+			want:   func(s *ufo_v2.Sighting) { s.Location.Coordinates.Latitude = 10 },
+		},
+		{
+			name:   "nested message",
+			paths:  []string{"location.name"},
+			update: &ufo_v2.Sighting{Location: &ufo_v2.Location{Name: "Area 51"}},
+			want:   func(s *ufo_v2.Sighting) { s.Location.Name = "Area 51" },
+		},
+		{
+			name:   "clear fields missing in request",
+			paths:  []string{"color_text", "duration", "location.coordinates"},
+			update: &ufo_v2.Sighting{},
+			want: func(s *ufo_v2.Sighting) {
+				s.ColorText = nil
+				s.Color = ufo_v2.Color_COLOR_UNSPECIFIED
+				s.Duration = nil
+				s.Location.Coordinates = nil
+			},
+		},
+		{
+			name:     "clear required location name",
+			paths:    []string{"location.name"},
+			update:   &ufo_v2.Sighting{},
+			wantCode: codes.InvalidArgument,
+			wantMsg:  "sighting location is required",
+		},
+		{
+			name:   "wildcard replaces all mutable fields",
+			paths:  []string{"*"},
+			update: &ufo_v2.Sighting{Location: &ufo_v2.Location{Name: "Area 51"}, Sound: ufo_v2.Sound_SOUND_ABSENT},
+			want: func(s *ufo_v2.Sighting) {
+				*s = ufo_v2.Sighting{Location: &ufo_v2.Location{Name: "Area 51"}, Sound: ufo_v2.Sound_SOUND_ABSENT}
+			},
+		},
+		{
+			name:   "empty mask replaces all mutable fields",
+			update: &ufo_v2.Sighting{Location: &ufo_v2.Location{Name: "Area 51"}, Description: "disc"},
+			want: func(s *ufo_v2.Sighting) {
+				*s = ufo_v2.Sighting{Location: &ufo_v2.Location{Name: "Area 51"}, Description: "disc"}
+			},
+		},
+		{
+			name:     "output-only field",
+			paths:    []string{"create_time"},
+			update:   &ufo_v2.Sighting{CreateTime: timestamppb.Now()},
+			wantCode: codes.InvalidArgument,
+			wantMsg:  `field "create_time" is output only`,
+		},
+		{
+			name:     "server-computed color",
+			paths:    []string{"color"},
+			update:   &ufo_v2.Sighting{Color: ufo_v2.Color_COLOR_RED},
+			wantCode: codes.InvalidArgument,
+			wantMsg:  `field "color" is output only`,
+		},
+		{
+			name:     "unknown field",
+			paths:    []string{"altitude"},
+			update:   &ufo_v2.Sighting{},
+			wantCode: codes.InvalidArgument,
+			wantMsg:  `unknown field "altitude"`,
+		},
+		{
+			name:     "unknown nested field",
+			paths:    []string{"location.altitude"},
+			update:   &ufo_v2.Sighting{},
+			wantCode: codes.InvalidArgument,
+			wantMsg:  `unknown field "location.altitude"`,
+		},
+		{
+			name:     "subfield of scalar",
+			paths:    []string{"description.text"},
+			update:   &ufo_v2.Sighting{},
+			wantCode: codes.InvalidArgument,
+			wantMsg:  `field "description" has no subfields`,
+		},
+		{
+			name:     "wildcard with other paths",
+			paths:    []string{"*", "description"},
+			update:   &ufo_v2.Sighting{},
+			wantCode: codes.InvalidArgument,
+			wantMsg:  `unknown field "*"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svc := NewUfoServiceV2(NewUfoService(store.New(store.DefaultShards), nil, nil))
+
+			created, err := svc.CreateSighting(ctx, &ufo_v2.CreateSightingRequest{Sighting: baseSightingV2()})
+			if err != nil {
+				t.Fatalf("CreateSighting: %v", err)
+			}
+
+			update := proto.CloneOf(tt.update)
+			update.Uuid = created.GetUuid()
+			var mask *fieldmaskpb.FieldMask
+			if tt.paths != nil {
+				mask = &fieldmaskpb.FieldMask{Paths: tt.paths}
+			}
+
+			got, err := svc.UpdateSighting(ctx, &ufo_v2.UpdateSightingRequest{Sighting: update, UpdateMask: mask})
+			if st := status.Convert(err); st.Code() != tt.wantCode || !strings.Contains(statusText(st), tt.wantMsg) {
+				t.Fatalf("UpdateSighting error = %v, want %v containing %q", err, tt.wantCode, tt.wantMsg)
+			}
+			if tt.wantCode != codes.OK {
+				// Отклоненное обновление не меняет наблюдение.
+				stored, err := svc.GetSighting(ctx, &ufo_v2.GetSightingRequest{Uuid: created.GetUuid()})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !proto.Equal(stored, created) {
+					t.Errorf("sighting changed after rejected update:\n got %v\nwant %v", stored, created)
+				}
+				return
+			}
+
+			want := baseSightingV2()
+			tt.want(want)
+			if got.GetUpdateTime() == nil {
+				t.Error("update_time is not set")
+			}
+			// Поля, которые задает сервер, сравниваем отдельно.
+			want.Uuid = created.GetUuid()
+			want.CreateTime = created.GetCreateTime()
+			want.UpdateTime = got.GetUpdateTime()
+			if want.ColorText != nil {
+				want.Color = created.GetColor()
+			}
+			if !proto.Equal(got, want) {
+				t.Errorf("UpdateSighting:\n got %v\nwant %v", got, want)
+			}
+		})
+	}
+}
+
+func TestUpdatePaths(t *testing.T) {
+	tests := []struct {
+		name           string
+		mask           *fieldmaskpb.FieldMask
+		want           []string
+		wantViolations []string
+	}{
+		{name: "nil mask", want: mutableFieldsV2},
+		{name: "empty mask", mask: &fieldmaskpb.FieldMask{}, want: mutableFieldsV2},
+		{name: "wildcard", mask: &fieldmaskpb.FieldMask{Paths: []string{"*"}}, want: mutableFieldsV2},
+		{
+			name: "nested paths",
+			mask: &fieldmaskpb.FieldMask{Paths: []string{"location.name", "location.coordinates.longitude", "sound"}},
+			want: []string{"location.name", "location.coordinates.longitude", "sound"},
+		},
+		{
+			name: "all violations reported",
+			mask: &fieldmaskpb.FieldMask{Paths: []string{"uuid", "delete_time", "foo", "location.coordinates.altitude", "sound.value"}},
+			wantViolations: []string{
+				`field "uuid" is output only`,
+				`field "delete_time" is output only`,
+				`unknown field "foo"`,
+				`unknown field "location.coordinates.altitude"`,
+				`field "sound" has no subfields`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := updatePaths(tt.mask)
+			if tt.wantViolations == nil {
+				if err != nil {
+					t.Fatalf("updatePaths() = %v", err)
+				}
+				if !slices.Equal(got, tt.want) {
+					t.Errorf("updatePaths() = %v, want %v", got, tt.want)
+				}
+				return
+			}
+
+			st := status.Convert(err)
+			if st.Code() != codes.InvalidArgument {
+				t.Fatalf("updatePaths() code = %v, want InvalidArgument", st.Code())
+			}
+			var descriptions []string
+			for _, v := range badRequest(st).GetFieldViolations() {
+				if v.GetField() != "update_mask" {
+					t.Errorf("violation field = %q, want update_mask", v.GetField())
+				}
+				descriptions = append(descriptions, v.GetDescription())
+			}
+			if !slices.Equal(descriptions, tt.wantViolations) {
+				t.Errorf("violations = %q, want %q", descriptions, tt.wantViolations)
+			}
+		})
+	}
+}
+
+func TestUpdateSightingRejectsBadTargets(t *testing.T) {
+	ctx := context.Background()
+	svc := NewUfoServiceV2(NewUfoService(store.New(store.DefaultShards), nil, nil))
+
+	created, err := svc.CreateSighting(ctx, &ufo_v2.CreateSightingRequest{Sighting: baseSightingV2()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	deleted, err := svc.CreateSighting(ctx, &ufo_v2.CreateSightingRequest{Sighting: baseSightingV2()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.DeleteSighting(ctx, &ufo_v2.DeleteSightingRequest{Uuid: deleted.GetUuid()}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		uuid     string
+		wantCode codes.Code
+	}{
+		{name: "invalid uuid", uuid: "42", wantCode: codes.InvalidArgument},
+		{name: "missing", uuid: missingSightingUUID, wantCode: codes.NotFound},
+		{name: "deleted", uuid: deleted.GetUuid(), wantCode: codes.FailedPrecondition},
+		{name: "existing", uuid: created.GetUuid(), wantCode: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.UpdateSighting(ctx, &ufo_v2.UpdateSightingRequest{
+				Sighting:   &ufo_v2.Sighting{Uuid: tt.uuid, Description: "disc"},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+			})
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("UpdateSighting code = %v, want %v (err: %v)", got, tt.wantCode, err)
+			}
+		})
+	}
+}
+
+func badRequest(st *status.Status) *errdetails.BadRequest {
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			return br
+		}
+	}
+	return nil
+}
+
+// statusText сообщение статуса вместе с описаниями нарушений BadRequest.
+func statusText(st *status.Status) string {
+	text := st.Message()
+	for _, v := range badRequest(st).GetFieldViolations() {
+		text += "; " + v.GetDescription()
+	}
+	return text
+}
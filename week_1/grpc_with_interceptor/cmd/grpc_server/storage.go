@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/colors"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/config"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/persistence"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/store"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+)
+
+// newStore создает хранилище наблюдений. Если задан storage.dir, состояние
+// восстанавливается из снимка и WAL, а мутации пишутся в WAL. Возвращаемая
+// функция закрытия сохраняет снимок; ее нужно вызывать после остановки gRPC,
+// когда новых записей уже нет.
+func newStore(ctx context.Context, cfg config.StorageConfig) (*store.Store, func(context.Context) error, error) {
+	if cfg.Dir == "" {
+		return store.New(cfg.Shards), func(context.Context) error { return nil }, nil
+	}
+
+	wal, sightings, err := persistence.Open(cfg.Persistence())
+	if err != nil {
+		return nil, nil, fmt.Errorf("open storage: %w", err)
+	}
+
+	st := store.New(cfg.Shards, store.WithJournal(wal))
+	st.Restore(sightings)
+	if n, err := migrateColors(st); err != nil {
+		_ = wal.Close()
+		return nil, nil, fmt.Errorf("migrate colors: %w", err)
+	} else if n > 0 {
+		slog.Info("🎨 Normalized sighting colors", "count", n)
+	}
+
+	go wal.Run(ctx, st.List)
+
+	closeFn := func(context.Context) error {
+		if err := wal.Snapshot(st.List); err != nil {
+			_ = wal.Close()
+			return err
+		}
+		return wal.Close()
+	}
+	return st, closeFn, nil
+}
+
+// migrateColors заполняет normalized_color у наблюдений, сохраненных до
+// появления палитры или с устаревшим значением. updated_at не меняется:
+// данные наблюдения остаются прежними. Возвращает число обновленных записей.
+func migrateColors(st *store.Store) (int, error) {
+	var migrated int
+	for _, s := range st.List() {
+		if s.GetInfo() == nil {
+			continue
+		}
+		want := colors.Normalize(s.GetInfo().GetColor().GetValue())
+		if s.GetInfo().GetNormalizedColor() == want {
+			continue
+		}
+		err := st.Update(s.GetUuid(), func(sighting *ufo_v1.Sighting) error {
+			sighting.Info.NormalizedColor = want
+			return nil
+		})
+		if err != nil {
+			return migrated, err
+		}
+		migrated++
+	}
+	return migrated, nil
+}
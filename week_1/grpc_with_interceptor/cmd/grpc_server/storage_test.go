@@ -0,0 +1,63 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/store"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestMigrateColors(t *testing.T) {
+	updatedAt := timestamppb.New(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	withColor := func(uuid, color string, normalized ufo_v1.Color) *ufo_v1.Sighting {
+		info := &ufo_v1.SightingInfo{Location: "Roswell", NormalizedColor: normalized}
+		if color != "" {
+			info.Color = wrapperspb.String(color)
+		}
+		return &ufo_v1.Sighting{Uuid: uuid, Info: info, UpdatedAt: updatedAt}
+	}
+
+	st := store.New(4)
+	st.Restore([]*ufo_v1.Sighting{
+		withColor("legacy", "алая", ufo_v1.Color_COLOR_UNSPECIFIED),
+		withColor("stale", "#0000ff", ufo_v1.Color_COLOR_GREEN),
+		withColor("current", "green", ufo_v1.Color_COLOR_GREEN),
+		withColor("no-color", "", ufo_v1.Color_COLOR_UNSPECIFIED),
+		{Uuid: "no-info"},
+	})
+
+	n, err := migrateColors(st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("migrated %d sightings, want 2", n)
+	}
+
+	want := map[string]ufo_v1.Color{
+		"legacy":   ufo_v1.Color_COLOR_RED,
+		"stale":    ufo_v1.Color_COLOR_BLUE,
+		"current":  ufo_v1.Color_COLOR_GREEN,
+		"no-color": ufo_v1.Color_COLOR_UNSPECIFIED,
+	}
+	for uuid, color := range want {
+		s, _ := st.Get(uuid)
+		if got := s.GetInfo().GetNormalizedColor(); got != color {
+			t.Errorf("%s normalized_color = %v, want %v", uuid, got, color)
+		}
+		if !s.GetUpdatedAt().AsTime().Equal(updatedAt.AsTime()) {
+			t.Errorf("%s updated_at changed to %v", uuid, s.GetUpdatedAt().AsTime())
+		}
+	}
+	if s, _ := st.Get("no-info"); s.GetInfo() != nil {
+		t.Errorf("sighting without info got info %v", s.GetInfo())
+	}
+
+	// Повторный запуск ничего не меняет.
+	if n, err := migrateColors(st); err != nil || n != 0 {
+		t.Errorf("second migrateColors = %d, %v; want 0, nil", n, err)
+	}
+}
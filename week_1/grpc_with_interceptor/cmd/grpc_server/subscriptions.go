@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// errWebhooksDisabled возвращается RPC подписок, когда webhooks.enabled=false.
+var errWebhooksDisabled = status.Error(codes.Unimplemented, "webhooks are disabled")
+
+// publish отправляет событие подписчикам, если webhook включены.
+func (u *ufoService) publish(event ufo_v1.EventType, sighting *ufo_v1.Sighting) {
+	if u.webhooks != nil {
+		u.webhooks.Publish(event, sighting)
+	}
+}
+
+func (u *ufoService) CreateSubscription(_ context.Context, req *ufo_v1.CreateSubscriptionRequest) (*ufo_v1.CreateSubscriptionResponse, error) {
+	if u.webhooks == nil {
+		return nil, errWebhooksDisabled
+	}
+
+	sub, secret, err := u.webhooks.Subscribe(req)
+	if err != nil {
+		return nil, err
+	}
+	return &ufo_v1.CreateSubscriptionResponse{
+		Subscription: sub,
+		Secret:       secret,
+	}, nil
+}
+
+func (u *ufoService) ListSubscriptions(_ context.Context, _ *ufo_v1.ListSubscriptionsRequest) (*ufo_v1.ListSubscriptionsResponse, error) {
+	if u.webhooks == nil {
+		return nil, errWebhooksDisabled
+	}
+
+	return &ufo_v1.ListSubscriptionsResponse{
+		Subscriptions: u.webhooks.Subscriptions(),
+	}, nil
+}
+
+func (u *ufoService) DeleteSubscription(_ context.Context, req *ufo_v1.DeleteSubscriptionRequest) (*emptypb.Empty, error) {
+	if u.webhooks == nil {
+		return nil, errWebhooksDisabled
+	}
+
+	if err := u.webhooks.Unsubscribe(req.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (u *ufoService) ListDeliveries(_ context.Context, req *ufo_v1.ListDeliveriesRequest) (*ufo_v1.ListDeliveriesResponse, error) {
+	if u.webhooks == nil {
+		return nil, errWebhooksDisabled
+	}
+
+	return &ufo_v1.ListDeliveriesResponse{
+		Deliveries: u.webhooks.Deliveries(req.GetSubscriptionId(), req.GetStatus()),
+	}, nil
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"time"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/interceptor"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	ufo_v2 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v2"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxClockSkew насколько observed_at может опережать часы сервера: часы
+// клиента редко идут точно, а правило lt_now из protoc-gen-validate не дает
+// задать такой допуск.
+const maxClockSkew = time.Minute
+
+// observedAtNotInFuture проверяет, что время наблюдения в запросах создания
+// и обновления обеих версий API не позже now() + maxClockSkew.
+func observedAtNotInFuture(now func() time.Time) interceptor.Check {
+	return func(req interface{}) []*errdetails.BadRequest_FieldViolation {
+		var field string
+		var observedAt *timestamppb.Timestamp
+		switch r := req.(type) {
+		case *ufo_v1.CreateRequest:
+			field, observedAt = "info.observed_at", r.GetInfo().GetObservedAt()
+		case *ufo_v1.UpdateRequest:
+			field, observedAt = "update_info.observed_at", r.GetUpdateInfo().GetObservedAt()
+		case *ufo_v2.CreateSightingRequest:
+			field, observedAt = "sighting.observed_at", r.GetSighting().GetObservedAt()
+		case *ufo_v2.UpdateSightingRequest:
+			field, observedAt = "sighting.observed_at", r.GetSighting().GetObservedAt()
+		default:
+			return nil
+		}
+
+		switch {
+		case observedAt == nil:
+			return nil
+		case !observedAt.IsValid():
+			return []*errdetails.BadRequest_FieldViolation{{Field: field, Description: "value must be a valid timestamp"}}
+		case observedAt.AsTime().After(now().Add(maxClockSkew)):
+			return []*errdetails.BadRequest_FieldViolation{{Field: field, Description: "value must not be in the future"}}
+		default:
+			return nil
+		}
+	}
+}
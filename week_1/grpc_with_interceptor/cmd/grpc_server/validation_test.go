@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/interceptor"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	ufo_v2 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v2"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const validUUID = "3f0c6b8e-4a51-4d6e-9b7a-2f6f1f0b9c11"
+
+func TestValidationRules(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	validate := interceptor.ValidationInterceptor(observedAtNotInFuture(func() time.Time { return now }))
+
+	info := func(modify func(*ufo_v1.SightingInfo)) *ufo_v1.CreateRequest {
+		i := &ufo_v1.SightingInfo{
+			ObservedAt:  timestamppb.New(now.Add(-time.Hour)),
+			Location:    "Roswell",
+			Description: "светящийся диск",
+		}
+		modify(i)
+		return &ufo_v1.CreateRequest{Info: i}
+	}
+	update := func(u *ufo_v1.SightingUpdateInfo) *ufo_v1.UpdateRequest {
+		return &ufo_v1.UpdateRequest{Uuid: validUUID, UpdateInfo: u}
+	}
+
+	tests := []struct {
+		name string
+		req  interface{}
+		// wantFields поля с нарушениями; пусто — запрос валиден.
+		wantFields []string
+	}{
+		{
+			name: "valid create",
+			req:  info(func(*ufo_v1.SightingInfo) {}),
+		},
+		{
+			name: "duration at upper bound",
+			req:  info(func(i *ufo_v1.SightingInfo) { i.DurationSeconds = wrapperspb.Int32(86400) }),
+		},
+		{
+			name:       "zero duration",
+			req:        info(func(i *ufo_v1.SightingInfo) { i.DurationSeconds = wrapperspb.Int32(0) }),
+			wantFields: []string{"info.duration_seconds"},
+		},
+		{
+			name:       "negative duration",
+			req:        info(func(i *ufo_v1.SightingInfo) { i.DurationSeconds = wrapperspb.Int32(-5) }),
+			wantFields: []string{"info.duration_seconds"},
+		},
+		{
+			name:       "duration above one day",
+			req:        info(func(i *ufo_v1.SightingInfo) { i.DurationSeconds = wrapperspb.Int32(86401) }),
+			wantFields: []string{"info.duration_seconds"},
+		},
+		{
+			name: "description of 1000 runes",
+			req:  info(func(i *ufo_v1.SightingInfo) { i.Description = strings.Repeat("ж", 1000) }),
+		},
+		{
+			name:       "description too long",
+			req:        info(func(i *ufo_v1.SightingInfo) { i.Description = strings.Repeat("ж", 1001) }),
+			wantFields: []string{"info.description"},
+		},
+		{
+			name:       "empty color",
+			req:        info(func(i *ufo_v1.SightingInfo) { i.Color = wrapperspb.String("") }),
+			wantFields: []string{"info.color"},
+		},
+		{
+			name:       "color too long",
+			req:        info(func(i *ufo_v1.SightingInfo) { i.Color = wrapperspb.String(strings.Repeat("a", 33)) }),
+			wantFields: []string{"info.color"},
+		},
+		{
+			name: "observed_at within clock skew",
+			req:  info(func(i *ufo_v1.SightingInfo) { i.ObservedAt = timestamppb.New(now.Add(30 * time.Second)) }),
+		},
+		{
+			name:       "observed_at in the future",
+			req:        info(func(i *ufo_v1.SightingInfo) { i.ObservedAt = timestamppb.New(now.Add(2 * time.Minute)) }),
+			wantFields: []string{"info.observed_at"},
+		},
+		{
+			name: "rules and custom check reported together",
+			req: info(func(i *ufo_v1.SightingInfo) {
+				i.DurationSeconds = wrapperspb.Int32(-1)
+				i.ObservedAt = timestamppb.New(now.Add(time.Hour))
+			}),
+			wantFields: []string{"info.duration_seconds", "info.observed_at"},
+		},
+		{
+			name: "valid update",
+			req:  update(&ufo_v1.SightingUpdateInfo{DurationSeconds: wrapperspb.Int32(60), Color: wrapperspb.String("green")}),
+		},
+		{
+			name:       "update with negative duration",
+			req:        update(&ufo_v1.SightingUpdateInfo{DurationSeconds: wrapperspb.Int32(-1)}),
+			wantFields: []string{"update_info.duration_seconds"},
+		},
+		{
+			name:       "update with long description",
+			req:        update(&ufo_v1.SightingUpdateInfo{Description: wrapperspb.String(strings.Repeat("a", 1001))}),
+			wantFields: []string{"update_info.description"},
+		},
+		{
+			name:       "update with observed_at in the future",
+			req:        update(&ufo_v1.SightingUpdateInfo{ObservedAt: timestamppb.New(now.Add(24 * time.Hour))}),
+			wantFields: []string{"update_info.observed_at"},
+		},
+		{
+			name:       "update with malformed uuid",
+			req:        &ufo_v1.UpdateRequest{Uuid: "42", UpdateInfo: &ufo_v1.SightingUpdateInfo{}},
+			wantFields: []string{"uuid"},
+		},
+		{
+			name:       "get with malformed uuid",
+			req:        &ufo_v1.GetRequest{Uuid: "not-a-uuid"},
+			wantFields: []string{"uuid"},
+		},
+		{
+			name:       "delete with malformed uuid",
+			req:        &ufo_v1.DeleteRequest{Uuid: ""},
+			wantFields: []string{"uuid"},
+		},
+		{
+			name: "delete with valid uuid",
+			req:  &ufo_v1.DeleteRequest{Uuid: validUUID},
+		},
+		{
+			name: "v2 valid create",
+			req: &ufo_v2.CreateSightingRequest{Sighting: &ufo_v2.Sighting{
+				ObservedAt: timestamppb.New(now.Add(-time.Hour)),
+				Location:   &ufo_v2.Location{Name: "Roswell", Coordinates: &ufo_v2.Coordinates{Latitude: 33.39, Longitude: -104.52}},
+				Duration:   durationpb.New(time.Minute),
+			}},
+		},
+		{
+			name: "v2 create with invalid coordinates and duration",
+			req: &ufo_v2.CreateSightingRequest{Sighting: &ufo_v2.Sighting{
+				Location: &ufo_v2.Location{Name: "Roswell", Coordinates: &ufo_v2.Coordinates{Latitude: 91, Longitude: -181}},
+				Duration: durationpb.New(25 * time.Hour),
+			}},
+			wantFields: []string{"sighting.duration", "sighting.location.coordinates.latitude", "sighting.location.coordinates.longitude"},
+		},
+		{
+			name: "v2 update with observed_at in the future",
+			req: &ufo_v2.UpdateSightingRequest{Sighting: &ufo_v2.Sighting{
+				Uuid:       validUUID,
+				ObservedAt: timestamppb.New(now.Add(time.Hour)),
+			}},
+			wantFields: []string{"sighting.observed_at"},
+		},
+	}
+
+	handler := func(context.Context, interface{}) (interface{}, error) { return nil, nil }
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validate(context.Background(), tt.req, &grpc.UnaryServerInfo{}, handler)
+			if len(tt.wantFields) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			st := status.Convert(err)
+			if st.Code() != codes.InvalidArgument {
+				t.Fatalf("code = %v, want InvalidArgument (err: %v)", st.Code(), err)
+			}
+			var fields []string
+			for _, d := range st.Details() {
+				if br, ok := d.(*errdetails.BadRequest); ok {
+					for _, v := range br.GetFieldViolations() {
+						fields = append(fields, v.GetField())
+					}
+				}
+			}
+			slices.Sort(fields)
+			if !slices.Equal(fields, tt.wantFields) {
+				t.Errorf("violations = %v, want %v", fields, tt.wantFields)
+			}
+		})
+	}
+}
@@ -0,0 +1,350 @@
+// ufo_loadgen — нагрузочный тест UFOService по gRPC или через REST gateway.
+//
+//	ufo_loadgen -protocol grpc -concurrency 32 -duration 30s
+//	ufo_loadgen -protocol rest -rps 500 -mix create=10,get=60,getall=5,update=20,delete=5 -json report.json
+//
+// Без -rps воркеры шлют запросы без пауз (замкнутая модель, нагрузку задает
+// -concurrency). С -rps запросы запускаются с заданной частотой; если все
+// воркеры заняты, тик пропускается и учитывается в отчете как dropped.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	ufoV1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Операции нагрузочного теста.
+const (
+	opCreate = "create"
+	opGet    = "get"
+	opGetAll = "getall"
+	opUpdate = "update"
+	opDelete = "delete"
+)
+
+const defaultMix = "create=20,get=40,getall=5,update=25,delete=10"
+
+type options struct {
+	protocol    string
+	grpcAddr    string
+	restAddr    string
+	concurrency int
+	rps         float64
+	duration    time.Duration
+	timeout     time.Duration
+	mix         string
+	seed        int
+	jsonOut     string
+}
+
+func main() {
+	var opts options
+	flag.StringVar(&opts.protocol, "protocol", "grpc", "протокол: grpc или rest")
+	flag.StringVar(&opts.grpcAddr, "grpc-addr", "localhost:50051", "адрес gRPC сервера")
+	flag.StringVar(&opts.restAddr, "rest-addr", "http://localhost:8081", "базовый URL REST gateway")
+	flag.IntVar(&opts.concurrency, "concurrency", 16, "количество параллельных воркеров")
+	flag.Float64Var(&opts.rps, "rps", 0, "целевое количество запросов в секунду (0 — без ограничения)")
+	flag.DurationVar(&opts.duration, "duration", 30*time.Second, "длительность теста")
+	flag.DurationVar(&opts.timeout, "timeout", 5*time.Second, "таймаут одного запроса")
+	flag.StringVar(&opts.mix, "mix", defaultMix, "веса операций create, get, getall, update, delete")
+	flag.IntVar(&opts.seed, "seed", 100, "сколько наблюдений создать перед тестом")
+	flag.StringVar(&opts.jsonOut, "json", "", "записать отчет в JSON файл (- для stdout вместо текста)")
+	flag.Parse()
+
+	if err := run(opts); err != nil {
+		log.Printf("❌ %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(opts options) error {
+	if opts.concurrency < 1 {
+		return errors.New("-concurrency must be positive")
+	}
+	mix, err := parseMix(opts.mix)
+	if err != nil {
+		return err
+	}
+
+	t, targetAddr, closeFn, err := newTarget(opts)
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	ids := &idPool{}
+	log.Printf("🌱 Создаем %d наблюдений перед тестом\n", opts.seed)
+	for range opts.seed {
+		reqCtx, cancel := context.WithTimeout(ctx, opts.timeout)
+		id, err := t.Create(reqCtx)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("seed: %w", err)
+		}
+		ids.add(id)
+	}
+
+	log.Printf("🚀 Нагрузка %s на %s: %d воркеров, %s\n", opts.protocol, targetAddr, opts.concurrency, opts.duration)
+
+	rec := newRecorder()
+	runCtx, cancel := context.WithTimeout(ctx, opts.duration)
+	defer cancel()
+
+	var tokens chan struct{}
+	var dropped atomic.Int64
+	if opts.rps > 0 {
+		tokens = make(chan struct{}, opts.concurrency)
+		go dispatch(runCtx, opts.rps, tokens, &dropped)
+	}
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for range opts.concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				if tokens != nil {
+					select {
+					case <-runCtx.Done():
+						return
+					case <-tokens:
+					}
+				} else if runCtx.Err() != nil {
+					return
+				}
+
+				reqCtx, reqCancel := context.WithTimeout(context.WithoutCancel(runCtx), opts.timeout)
+				op, d, err := execute(reqCtx, t, ids, mix.pick())
+				reqCancel()
+				rec.record(op, d, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	rep := rec.report(time.Since(start))
+	rep.Protocol = opts.protocol
+	rep.Target = targetAddr
+	rep.Concurrency = opts.concurrency
+	rep.TargetRPS = opts.rps
+	rep.Dropped = dropped.Load()
+
+	return writeReport(rep, opts.jsonOut)
+}
+
+// execute выполняет операцию op и возвращает фактически выполненную операцию
+// и ее длительность. Операциям над существующим наблюдением uuid берется из
+// пула; если пул пуст, вместо них выполняется create, и в отчет он попадает
+// как create.
+func execute(ctx context.Context, t target, ids *idPool, op string) (string, time.Duration, error) {
+	var id string
+	if op == opGet || op == opUpdate || op == opDelete {
+		var ok bool
+		if op == opDelete {
+			id, ok = ids.take()
+		} else {
+			id, ok = ids.random()
+		}
+		if !ok {
+			op = opCreate
+		}
+	}
+
+	start := time.Now()
+	var err error
+	switch op {
+	case opCreate:
+		id, err = t.Create(ctx)
+		if err == nil {
+			defer ids.add(id)
+		}
+	case opGet:
+		err = t.Get(ctx, id)
+	case opGetAll:
+		err = t.GetAll(ctx)
+	case opUpdate:
+		err = t.Update(ctx, id)
+	case opDelete:
+		err = t.Delete(ctx, id)
+	}
+	return op, time.Since(start), err
+}
+
+// dispatch выдает токены воркерам с частотой rps. Если воркеры не успевают
+// забрать токен, он отбрасывается и учитывается в dropped.
+func dispatch(ctx context.Context, rps float64, tokens chan<- struct{}, dropped *atomic.Int64) {
+	interval := max(time.Duration(float64(time.Second)/rps), time.Millisecond)
+	perTick := rps * interval.Seconds()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var budget float64
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		budget += perTick
+		for ; budget >= 1; budget-- {
+			select {
+			case tokens <- struct{}{}:
+			default:
+				dropped.Add(1)
+			}
+		}
+	}
+}
+
+func newTarget(opts options) (target, string, func(), error) {
+	switch opts.protocol {
+	case "grpc":
+		conn, err := grpc.NewClient(opts.grpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, "", nil, fmt.Errorf("dial %s: %w", opts.grpcAddr, err)
+		}
+		closeFn := func() {
+			if err := conn.Close(); err != nil {
+				log.Printf("failed to close connection: %v\n", err)
+			}
+		}
+		return &grpcTarget{client: ufoV1.NewUFOServiceClient(conn)}, opts.grpcAddr, closeFn, nil
+	case "rest":
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.MaxIdleConnsPerHost = opts.concurrency
+		client := &http.Client{Transport: transport}
+		return &restTarget{baseURL: opts.restAddr, client: client}, opts.restAddr, transport.CloseIdleConnections, nil
+	default:
+		return nil, "", nil, fmt.Errorf("unsupported protocol %q (grpc, rest)", opts.protocol)
+	}
+}
+
+func writeReport(rep Report, jsonOut string) error {
+	if jsonOut != "-" {
+		if err := rep.writeText(os.Stdout); err != nil {
+			return err
+		}
+	}
+	if jsonOut == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(rep, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	if jsonOut == "-" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(jsonOut, data, 0o644)
+}
+
+// operationMix взвешенный выбор операции.
+type operationMix struct {
+	ops     []string
+	weights []int
+	total   int
+}
+
+func parseMix(s string) (*operationMix, error) {
+	mix := &operationMix{}
+	for part := range strings.SplitSeq(s, ",") {
+		name, rawWeight, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return nil, fmt.Errorf("-mix: expected op=weight, got %q", part)
+		}
+		switch name {
+		case opCreate, opGet, opGetAll, opUpdate, opDelete:
+		default:
+			return nil, fmt.Errorf("-mix: unknown operation %q", name)
+		}
+		weight, err := strconv.Atoi(rawWeight)
+		if err != nil || weight < 0 {
+			return nil, fmt.Errorf("-mix: invalid weight for %s: %q", name, rawWeight)
+		}
+		if weight == 0 {
+			continue
+		}
+		mix.ops = append(mix.ops, name)
+		mix.weights = append(mix.weights, weight)
+		mix.total += weight
+	}
+	if mix.total == 0 {
+		return nil, errors.New("-mix: at least one operation must have positive weight")
+	}
+	return mix, nil
+}
+
+func (m *operationMix) pick() string {
+	n := rand.IntN(m.total)
+	for i, w := range m.weights {
+		if n < w {
+			return m.ops[i]
+		}
+		n -= w
+	}
+	return m.ops[len(m.ops)-1]
+}
+
+// idPool идентификаторы созданных наблюдений, доступные для get/update/delete.
+type idPool struct {
+	mu  sync.Mutex
+	ids []string
+}
+
+func (p *idPool) add(id string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.ids = append(p.ids, id)
+}
+
+func (p *idPool) random() (string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.ids) == 0 {
+		return "", false
+	}
+	return p.ids[rand.IntN(len(p.ids))], true
+}
+
+// take удаляет случайный идентификатор из пула, чтобы удаленное наблюдение
+// не удалялось повторно.
+func (p *idPool) take() (string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.ids) == 0 {
+		return "", false
+	}
+	i := rand.IntN(len(p.ids))
+	id := p.ids[i]
+	p.ids[i] = p.ids[len(p.ids)-1]
+	p.ids = p.ids[:len(p.ids)-1]
+	return id, true
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseMix(t *testing.T) {
+	tests := []struct {
+		name        string
+		mix         string
+		wantOps     []string
+		wantWeights []int
+		wantErr     string
+	}{
+		{
+			name:        "all operations",
+			mix:         "create=10, get=60,getall=5,update=20,delete=5",
+			wantOps:     []string{opCreate, opGet, opGetAll, opUpdate, opDelete},
+			wantWeights: []int{10, 60, 5, 20, 5},
+		},
+		{name: "zero weight is skipped", mix: "create=1,delete=0", wantOps: []string{opCreate}, wantWeights: []int{1}},
+		{name: "missing weight", mix: "create", wantErr: `expected op=weight, got "create"`},
+		{name: "unknown operation", mix: "create=1,list=2", wantErr: `unknown operation "list"`},
+		{name: "negative weight", mix: "get=-1", wantErr: `invalid weight for get: "-1"`},
+		{name: "non-numeric weight", mix: "get=often", wantErr: `invalid weight for get: "often"`},
+		{name: "zero total", mix: "create=0,get=0", wantErr: "at least one operation must have positive weight"},
+		{name: "empty", mix: "", wantErr: "expected op=weight"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mix, err := parseMix(tt.mix)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("parseMix(%q) error = %v, want containing %q", tt.mix, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseMix(%q): %v", tt.mix, err)
+			}
+			if !slices.Equal(mix.ops, tt.wantOps) || !slices.Equal(mix.weights, tt.wantWeights) {
+				t.Errorf("mix = %v %v, want %v %v", mix.ops, mix.weights, tt.wantOps, tt.wantWeights)
+			}
+			for range 100 {
+				if op := mix.pick(); !slices.Contains(tt.wantOps, op) {
+					t.Fatalf("pick() = %q, not in %v", op, tt.wantOps)
+				}
+			}
+		})
+	}
+}
+
+func TestIDPoolTake(t *testing.T) {
+	var pool idPool
+	if _, ok := pool.take(); ok {
+		t.Fatal("take() from empty pool returned an id")
+	}
+
+	want := []string{"a", "b", "c"}
+	for _, id := range want {
+		pool.add(id)
+	}
+
+	// Каждый идентификатор выдается ровно один раз.
+	var got []string
+	for range want {
+		id, ok := pool.take()
+		if !ok {
+			t.Fatal("take() returned no id while pool is not empty")
+		}
+		got = append(got, id)
+	}
+	slices.Sort(got)
+	if !slices.Equal(got, want) {
+		t.Errorf("taken ids = %v, want %v", got, want)
+	}
+
+	if _, ok := pool.take(); ok {
+		t.Error("take() after draining returned an id")
+	}
+	if _, ok := pool.random(); ok {
+		t.Error("random() after draining returned an id")
+	}
+}
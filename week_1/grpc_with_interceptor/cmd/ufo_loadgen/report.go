@@ -0,0 +1,180 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"google.golang.org/grpc/status"
+)
+
+// recorder собирает задержки и коды ошибок по операциям.
+type recorder struct {
+	mu        sync.Mutex
+	latencies map[string][]time.Duration
+	errors    map[string]int
+	codes     map[string]int
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		latencies: make(map[string][]time.Duration),
+		errors:    make(map[string]int),
+		codes:     make(map[string]int),
+	}
+}
+
+func (r *recorder) record(op string, d time.Duration, err error) {
+	code := status.Code(err).String()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.latencies[op] = append(r.latencies[op], d)
+	r.codes[code]++
+	if err != nil {
+		r.errors[op]++
+	}
+}
+
+// Latency перцентили задержки в миллисекундах.
+type Latency struct {
+	P50  float64 `json:"p50_ms"`
+	P90  float64 `json:"p90_ms"`
+	P99  float64 `json:"p99_ms"`
+	Max  float64 `json:"max_ms"`
+	Mean float64 `json:"mean_ms"`
+}
+
+// OpReport статистика одной операции.
+type OpReport struct {
+	Requests int     `json:"requests"`
+	Errors   int     `json:"errors"`
+	Latency  Latency `json:"latency"`
+}
+
+// Report итог нагрузочного теста.
+type Report struct {
+	Protocol    string              `json:"protocol"`
+	Target      string              `json:"target"`
+	Duration    string              `json:"duration"`
+	Concurrency int                 `json:"concurrency"`
+	TargetRPS   float64             `json:"target_rps"`
+	Requests    int                 `json:"requests"`
+	Errors      int                 `json:"errors"`
+	Dropped     int64               `json:"dropped"`
+	Throughput  float64             `json:"throughput_rps"`
+	Latency     Latency             `json:"latency"`
+	Operations  map[string]OpReport `json:"operations"`
+	Codes       map[string]int      `json:"codes"`
+}
+
+func (r *recorder) report(elapsed time.Duration) Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rep := Report{
+		Duration:   elapsed.Round(time.Millisecond).String(),
+		Operations: make(map[string]OpReport, len(r.latencies)),
+		Codes:      make(map[string]int, len(r.codes)),
+	}
+
+	var all []time.Duration
+	for op, ds := range r.latencies {
+		all = append(all, ds...)
+		rep.Operations[op] = OpReport{
+			Requests: len(ds),
+			Errors:   r.errors[op],
+			Latency:  latency(ds),
+		}
+		rep.Requests += len(ds)
+		rep.Errors += r.errors[op]
+	}
+	for code, n := range r.codes {
+		rep.Codes[code] = n
+	}
+
+	rep.Latency = latency(all)
+	if elapsed > 0 {
+		rep.Throughput = float64(rep.Requests) / elapsed.Seconds()
+	}
+	return rep
+}
+
+func latency(ds []time.Duration) Latency {
+	if len(ds) == 0 {
+		return Latency{}
+	}
+
+	sorted := slices.Clone(ds)
+	slices.Sort(sorted)
+
+	var sum time.Duration
+	for _, d := range sorted {
+		sum += d
+	}
+
+	return Latency{
+		P50:  ms(percentile(sorted, 0.50)),
+		P90:  ms(percentile(sorted, 0.90)),
+		P99:  ms(percentile(sorted, 0.99)),
+		Max:  ms(sorted[len(sorted)-1]),
+		Mean: ms(sum / time.Duration(len(sorted))),
+	}
+}
+
+// percentile возвращает перцентиль p отсортированной выборки (nearest-rank).
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	idx := int(math.Ceil(float64(len(sorted))*p)) - 1
+	idx = max(0, min(idx, len(sorted)-1))
+	return sorted[idx]
+}
+
+func ms(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
+
+// writeText печатает отчет в виде таблиц.
+func (rep Report) writeText(w io.Writer) error {
+	fmt.Fprintf(w, "Протокол: %s (%s), длительность: %s, воркеров: %d", rep.Protocol, rep.Target, rep.Duration, rep.Concurrency)
+	if rep.TargetRPS > 0 {
+		fmt.Fprintf(w, ", целевой RPS: %.0f", rep.TargetRPS)
+	}
+	fmt.Fprintf(w, "\nЗапросов: %d, ошибок: %d, пропущено тиков: %d, пропускная способность: %.1f rps\n\n",
+		rep.Requests, rep.Errors, rep.Dropped, rep.Throughput)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "OPERATION\tREQUESTS\tERRORS\tP50 ms\tP90 ms\tP99 ms\tMAX ms\t")
+
+	ops := make([]string, 0, len(rep.Operations))
+	for op := range rep.Operations {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+	for _, op := range ops {
+		o := rep.Operations[op]
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%.2f\t%.2f\t%.2f\t%.2f\t\n",
+			op, o.Requests, o.Errors, o.Latency.P50, o.Latency.P90, o.Latency.P99, o.Latency.Max)
+	}
+	fmt.Fprintf(tw, "total\t%d\t%d\t%.2f\t%.2f\t%.2f\t%.2f\t\n",
+		rep.Requests, rep.Errors, rep.Latency.P50, rep.Latency.P90, rep.Latency.P99, rep.Latency.Max)
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w, "\nКоды ответов:")
+	codes := make([]string, 0, len(rep.Codes))
+	for code := range rep.Codes {
+		codes = append(codes, code)
+	}
+	sort.Slice(codes, func(i, j int) bool { return rep.Codes[codes[i]] > rep.Codes[codes[j]] })
+	for _, code := range codes {
+		fmt.Fprintf(w, "  %-20s %d\n", code, rep.Codes[code])
+	}
+	return nil
+}
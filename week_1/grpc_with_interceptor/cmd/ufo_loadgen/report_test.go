@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func durations(ms ...int) []time.Duration {
+	ds := make([]time.Duration, len(ms))
+	for i, m := range ms {
+		ds[i] = time.Duration(m) * time.Millisecond
+	}
+	return ds
+}
+
+func TestPercentile(t *testing.T) {
+	hundred := make([]int, 100)
+	for i := range hundred {
+		hundred[i] = i + 1
+	}
+
+	tests := []struct {
+		name   string
+		sorted []time.Duration
+		p      float64
+		want   time.Duration
+	}{
+		{name: "single", sorted: durations(7), p: 0.99, want: 7 * time.Millisecond},
+		{name: "p50 of two", sorted: durations(1, 2), p: 0.50, want: 1 * time.Millisecond},
+		{name: "p50 of four", sorted: durations(1, 2, 3, 4), p: 0.50, want: 2 * time.Millisecond},
+		{name: "p90 of ten", sorted: durations(1, 2, 3, 4, 5, 6, 7, 8, 9, 10), p: 0.90, want: 9 * time.Millisecond},
+		{name: "p99 of ten", sorted: durations(1, 2, 3, 4, 5, 6, 7, 8, 9, 10), p: 0.99, want: 10 * time.Millisecond},
+		{name: "p99 of hundred", sorted: durations(hundred...), p: 0.99, want: 99 * time.Millisecond},
+		{name: "p0", sorted: durations(1, 2, 3), p: 0, want: 1 * time.Millisecond},
+		{name: "p100", sorted: durations(1, 2, 3), p: 1, want: 3 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := percentile(tt.sorted, tt.p); got != tt.want {
+				t.Errorf("percentile(%v, %v) = %v, want %v", tt.sorted, tt.p, got, tt.want)
+			}
+		})
+	}
+}
+
+func testReport() Report {
+	rec := newRecorder()
+	for _, d := range durations(4, 1, 3, 2) {
+		rec.record(opGet, d, nil)
+	}
+	rec.record(opCreate, 10*time.Millisecond, status.Error(codes.InvalidArgument, "bad"))
+	rec.record(opCreate, 20*time.Millisecond, errors.New("connection reset"))
+
+	rep := rec.report(2 * time.Second)
+	rep.Protocol = "grpc"
+	rep.Target = "localhost:50051"
+	rep.Concurrency = 4
+	rep.TargetRPS = 100
+	return rep
+}
+
+func TestRecorderReport(t *testing.T) {
+	rep := testReport()
+
+	if rep.Requests != 6 || rep.Errors != 2 {
+		t.Errorf("requests, errors = %d, %d, want 6, 2", rep.Requests, rep.Errors)
+	}
+	if rep.Throughput != 3 {
+		t.Errorf("throughput = %v, want 3", rep.Throughput)
+	}
+	get := rep.Operations[opGet]
+	if get.Requests != 4 || get.Errors != 0 || get.Latency.P50 != 2 || get.Latency.Max != 4 || get.Latency.Mean != 2.5 {
+		t.Errorf("get = %+v, want 4 requests, p50 2ms, max 4ms, mean 2.5ms", get)
+	}
+	wantCodes := map[string]int{"OK": 4, "InvalidArgument": 1, "Unknown": 1}
+	for code, n := range wantCodes {
+		if rep.Codes[code] != n {
+			t.Errorf("codes[%s] = %d, want %d", code, rep.Codes[code], n)
+		}
+	}
+}
+
+func TestReportWriteText(t *testing.T) {
+	var buf bytes.Buffer
+	if err := testReport().writeText(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"Протокол: grpc (localhost:50051), длительность: 2s, воркеров: 4, целевой RPS: 100",
+		"Запросов: 6, ошибок: 2",
+		"пропускная способность: 3.0 rps",
+		"OPERATION",
+		"InvalidArgument",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("report does not contain %q:\n%s", want, out)
+		}
+	}
+
+	// Операции идут по алфавиту, итог — последней строкой таблицы.
+	create, get, total := strings.Index(out, "create"), strings.Index(out, "  get"), strings.Index(out, "total")
+	if create < 0 || get < 0 || total < 0 || !(create < get && get < total) {
+		t.Errorf("unexpected table order:\n%s", out)
+	}
+	// Коды отсортированы по убыванию количества.
+	if strings.Index(out, "OK") > strings.Index(out, "InvalidArgument") {
+		t.Errorf("codes are not sorted by count:\n%s", out)
+	}
+}
+
+func TestWriteReportJSON(t *testing.T) {
+	// Текстовый отчет пишется в stdout, перенаправляем его, чтобы не засорять вывод тестов.
+	stdout := os.Stdout
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Stdout = devNull
+	t.Cleanup(func() {
+		os.Stdout = stdout
+		devNull.Close()
+	})
+
+	path := filepath.Join(t.TempDir(), "report.json")
+	if err := writeReport(testReport(), path); err != nil {
+		t.Fatalf("writeReport: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("report is not JSON: %v\n%s", err, data)
+	}
+
+	for _, key := range []string{"protocol", "target", "duration", "throughput_rps", "latency", "operations", "codes"} {
+		if _, ok := got[key]; !ok {
+			t.Errorf("JSON report has no %q field:\n%s", key, data)
+		}
+	}
+	ops := got["operations"].(map[string]interface{})
+	get := ops[opGet].(map[string]interface{})
+	if lat := get["latency"].(map[string]interface{}); lat["p50_ms"] != 2.0 || lat["max_ms"] != 4.0 {
+		t.Errorf("get latency = %v, want p50_ms 2 and max_ms 4", lat)
+	}
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/fakedata"
+	ufoV1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// target выполняет операции UFOService по одному из протоколов.
+type target interface {
+	Create(ctx context.Context) (string, error)
+	Get(ctx context.Context, uuid string) error
+	GetAll(ctx context.Context) error
+	Update(ctx context.Context, uuid string) error
+	Delete(ctx context.Context, uuid string) error
+}
+
+// grpcTarget вызывает UFOService напрямую по gRPC.
+type grpcTarget struct {
+	client ufoV1.UFOServiceClient
+}
+
+func (t *grpcTarget) Create(ctx context.Context) (string, error) {
+	resp, err := t.client.Create(ctx, &ufoV1.CreateRequest{Info: fakedata.SightingInfo()})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetUuid(), nil
+}
+
+func (t *grpcTarget) Get(ctx context.Context, uuid string) error {
+	_, err := t.client.Get(ctx, &ufoV1.GetRequest{Uuid: uuid})
+	return err
+}
+
+func (t *grpcTarget) GetAll(ctx context.Context) error {
+	_, err := t.client.GetAll(ctx, &ufoV1.GetAllRequest{})
+	return err
+}
+
+func (t *grpcTarget) Update(ctx context.Context, uuid string) error {
+	_, err := t.client.Update(ctx, &ufoV1.UpdateRequest{Uuid: uuid, UpdateInfo: fakedata.SightingUpdateInfo()})
+	return err
+}
+
+func (t *grpcTarget) Delete(ctx context.Context, uuid string) error {
+	_, err := t.client.Delete(ctx, &ufoV1.DeleteRequest{Uuid: uuid})
+	return err
+}
+
+// restTarget вызывает UFOService через REST gateway. Ошибки переводятся
+// в gRPC статус по полю code тела ошибки gateway.
+type restTarget struct {
+	baseURL string
+	client  *http.Client
+}
+
+func (t *restTarget) Create(ctx context.Context) (string, error) {
+	var resp ufoV1.CreateResponse
+	err := t.do(ctx, http.MethodPost, "/api/v1/ufo", &ufoV1.CreateRequest{Info: fakedata.SightingInfo()}, &resp)
+	return resp.GetUuid(), err
+}
+
+func (t *restTarget) Get(ctx context.Context, uuid string) error {
+	return t.do(ctx, http.MethodGet, "/api/v1/ufo/"+url.PathEscape(uuid), nil, nil)
+}
+
+func (t *restTarget) GetAll(ctx context.Context) error {
+	return t.do(ctx, http.MethodGet, "/api/v1/ufo", nil, nil)
+}
+
+func (t *restTarget) Update(ctx context.Context, uuid string) error {
+	req := &ufoV1.UpdateRequest{UpdateInfo: fakedata.SightingUpdateInfo()}
+	return t.do(ctx, http.MethodPatch, "/api/v1/ufo/"+url.PathEscape(uuid), req, nil)
+}
+
+func (t *restTarget) Delete(ctx context.Context, uuid string) error {
+	return t.do(ctx, http.MethodDelete, "/api/v1/ufo/"+url.PathEscape(uuid), nil, nil)
+}
+
+func (t *restTarget) do(ctx context.Context, method, path string, body, out proto.Message) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := protojson.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(t.baseURL, "/")+path, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errBody struct {
+			Code    int32  `json:"code"`
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(data, &errBody) != nil || errBody.Code == 0 {
+			return status.Errorf(codes.Unknown, "HTTP %d", resp.StatusCode)
+		}
+		return status.Error(codes.Code(errBody.Code), errBody.Message)
+	}
+
+	if out != nil {
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, out); err != nil {
+			return fmt.Errorf("decode response: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	ufoV1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// sightingFlags флаги полей наблюдения для create и update. Заданные флаги
+// имеют приоритет над значениями из -file.
+type sightingFlags struct {
+	file        string
+	observedAt  timestampFlag
+	location    optionalString
+	description optionalString
+	color       optionalString
+	sound       optionalBool
+	duration    optionalInt32
+}
+
+func (f *sightingFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.file, "file", "", "JSON файл с полями наблюдения (- для stdin)")
+	fs.Var(&f.observedAt, "observed-at", "время наблюдения (RFC 3339 или now)")
+	fs.Var(&f.location, "location", "место наблюдения")
+	fs.Var(&f.description, "description", "описание объекта")
+	fs.Var(&f.color, "color", "цвет объекта")
+	fs.Var(&f.sound, "sound", "был ли звук")
+	fs.Var(&f.duration, "duration", "продолжительность наблюдения в секундах")
+}
+
+// readFile заполняет msg из JSON файла, если он задан.
+func (f *sightingFlags) readFile(msg proto.Message) error {
+	if f.file == "" {
+		return nil
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+	if f.file == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(f.file)
+	}
+	if err != nil {
+		return fmt.Errorf("read %s: %w", f.file, err)
+	}
+
+	if err := protojson.Unmarshal(data, msg); err != nil {
+		return fmt.Errorf("parse %s: %w", f.file, err)
+	}
+	return nil
+}
+
+func (f *sightingFlags) info() (*ufoV1.SightingInfo, error) {
+	info := &ufoV1.SightingInfo{}
+	if err := f.readFile(info); err != nil {
+		return nil, err
+	}
+
+	if f.observedAt.value != nil {
+		info.ObservedAt = f.observedAt.value
+	}
+	if f.location.set {
+		info.Location = f.location.value
+	}
+	if f.description.set {
+		info.Description = f.description.value
+	}
+	if f.color.set {
+		info.Color = f.color.wrapper()
+	}
+	if f.sound.set {
+		info.Sound = f.sound.wrapper()
+	}
+	if f.duration.set {
+		info.DurationSeconds = f.duration.wrapper()
+	}
+	return info, nil
+}
+
+func (f *sightingFlags) updateInfo() (*ufoV1.SightingUpdateInfo, error) {
+	info := &ufoV1.SightingUpdateInfo{}
+	if err := f.readFile(info); err != nil {
+		return nil, err
+	}
+
+	if f.observedAt.value != nil {
+		info.ObservedAt = f.observedAt.value
+	}
+	if f.location.set {
+		info.Location = f.location.wrapper()
+	}
+	if f.description.set {
+		info.Description = f.description.wrapper()
+	}
+	if f.color.set {
+		info.Color = f.color.wrapper()
+	}
+	if f.sound.set {
+		info.Sound = f.sound.wrapper()
+	}
+	if f.duration.set {
+		info.DurationSeconds = f.duration.wrapper()
+	}
+	return info, nil
+}
+
+// actionResult результат команд, сервер для которых возвращает Empty.
+type actionResult struct {
+	UUID   string `json:"uuid"`
+	Status string `json:"status"`
+}
+
+// uuidArg возвращает единственный позиционный аргумент — uuid наблюдения.
+func uuidArg(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("%w: ожидается ровно один uuid, получено аргументов: %d", errUsage, len(args))
+	}
+	return args[0], nil
+}
+
+func noArgs(args []string) error {
+	if len(args) != 0 {
+		return fmt.Errorf("%w: лишние аргументы %q", errUsage, args)
+	}
+	return nil
+}
+
+func setupCreate(fs *flag.FlagSet) func(context.Context, ufoV1.UFOServiceClient, []string) (any, error) {
+	var f sightingFlags
+	f.register(fs)
+
+	return func(ctx context.Context, client ufoV1.UFOServiceClient, args []string) (any, error) {
+		if err := noArgs(args); err != nil {
+			return nil, err
+		}
+		info, err := f.info()
+		if err != nil {
+			return nil, err
+		}
+		return client.Create(ctx, &ufoV1.CreateRequest{Info: info})
+	}
+}
+
+func setupGet(_ *flag.FlagSet) func(context.Context, ufoV1.UFOServiceClient, []string) (any, error) {
+	return func(ctx context.Context, client ufoV1.UFOServiceClient, args []string) (any, error) {
+		uuid, err := uuidArg(args)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.Get(ctx, &ufoV1.GetRequest{Uuid: uuid})
+		if err != nil {
+			return nil, err
+		}
+		return resp.GetSighting(), nil
+	}
+}
+
+func setupList(fs *flag.FlagSet) func(context.Context, ufoV1.UFOServiceClient, []string) (any, error) {
+	filter := fs.String("filter", "", "CEL выражение отбора, например 'info.color == \"green\" && !has(deleted_at)'")
+
+	return func(ctx context.Context, client ufoV1.UFOServiceClient, args []string) (any, error) {
+		if err := noArgs(args); err != nil {
+			return nil, err
+		}
+		return client.GetAll(ctx, &ufoV1.GetAllRequest{Filter: *filter})
+	}
+}
+
+func setupUpdate(fs *flag.FlagSet) func(context.Context, ufoV1.UFOServiceClient, []string) (any, error) {
+	var f sightingFlags
+	f.register(fs)
+
+	return func(ctx context.Context, client ufoV1.UFOServiceClient, args []string) (any, error) {
+		uuid, err := uuidArg(args)
+		if err != nil {
+			return nil, err
+		}
+		info, err := f.updateInfo()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := client.Update(ctx, &ufoV1.UpdateRequest{Uuid: uuid, UpdateInfo: info}); err != nil {
+			return nil, err
+		}
+		return &actionResult{UUID: uuid, Status: "updated"}, nil
+	}
+}
+
+func setupDelete(_ *flag.FlagSet) func(context.Context, ufoV1.UFOServiceClient, []string) (any, error) {
+	return func(ctx context.Context, client ufoV1.UFOServiceClient, args []string) (any, error) {
+		uuid, err := uuidArg(args)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := client.Delete(ctx, &ufoV1.DeleteRequest{Uuid: uuid}); err != nil {
+			return nil, err
+		}
+		return &actionResult{UUID: uuid, Status: "deleted"}, nil
+	}
+}
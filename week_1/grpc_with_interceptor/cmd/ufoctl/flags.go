@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// optionalString строковый флаг, который помнит, был ли он задан.
+type optionalString struct {
+	value string
+	set   bool
+}
+
+func (f *optionalString) String() string { return f.value }
+
+func (f *optionalString) Set(s string) error {
+	f.value, f.set = s, true
+	return nil
+}
+
+func (f *optionalString) wrapper() *wrapperspb.StringValue {
+	if !f.set {
+		return nil
+	}
+	return wrapperspb.String(f.value)
+}
+
+// optionalBool булев флаг, который помнит, был ли он задан (-sound, -sound=false).
+type optionalBool struct {
+	value bool
+	set   bool
+}
+
+func (f *optionalBool) String() string { return strconv.FormatBool(f.value) }
+
+func (f *optionalBool) IsBoolFlag() bool { return true }
+
+func (f *optionalBool) Set(s string) error {
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+	f.value, f.set = v, true
+	return nil
+}
+
+func (f *optionalBool) wrapper() *wrapperspb.BoolValue {
+	if !f.set {
+		return nil
+	}
+	return wrapperspb.Bool(f.value)
+}
+
+// optionalInt32 целочисленный флаг, который помнит, был ли он задан.
+type optionalInt32 struct {
+	value int32
+	set   bool
+}
+
+func (f *optionalInt32) String() string { return strconv.Itoa(int(f.value)) }
+
+func (f *optionalInt32) Set(s string) error {
+	v, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return err
+	}
+	f.value, f.set = int32(v), true
+	return nil
+}
+
+func (f *optionalInt32) wrapper() *wrapperspb.Int32Value {
+	if !f.set {
+		return nil
+	}
+	return wrapperspb.Int32(f.value)
+}
+
+// timestampFlag флаг времени в формате RFC 3339 или "now".
+type timestampFlag struct {
+	value *timestamppb.Timestamp
+}
+
+func (f *timestampFlag) String() string {
+	if f.value == nil {
+		return ""
+	}
+	return f.value.AsTime().Format(time.RFC3339)
+}
+
+func (f *timestampFlag) Set(s string) error {
+	if s == "now" {
+		f.value = timestamppb.Now()
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return fmt.Errorf("ожидается RFC 3339 (2024-01-02T15:04:05Z) или now: %w", err)
+	}
+	f.value = timestamppb.New(t)
+	return nil
+}
@@ -0,0 +1,211 @@
+// ufoctl — неинтерактивный клиент UFOService для shell-скриптов.
+//
+//	ufoctl create -location "Roswell" -color green -sound=true
+//	ufoctl get -output json <uuid>
+//	ufoctl list -output yaml
+//	ufoctl list -filter 'info.duration_seconds > 300 && !has(deleted_at)'
+//	ufoctl update -file patch.json <uuid>
+//	ufoctl delete <uuid>
+//
+// Код выхода: 0 — успех, 1 — локальная ошибка, 2 — неверные аргументы,
+// 10 + код gRPC статуса — ошибка сервера (например, 15 — NotFound, 13 — InvalidArgument).
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/clientinterceptor"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/tlsconf"
+	ufoV1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultAddress = "localhost:50051"
+	defaultTimeout = 5 * time.Second
+)
+
+// Коды выхода, не связанные с ответом сервера.
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+	// exitStatusBase смещение кода выхода для ошибок gRPC: 10 + codes.Code.
+	exitStatusBase = 10
+)
+
+// errUsage означает неверные аргументы командной строки.
+var errUsage = errors.New("usage error")
+
+// command подкоманда ufoctl.
+type command struct {
+	name  string
+	args  string
+	usage string
+	// setup регистрирует флаги подкоманды и возвращает функцию ее выполнения.
+	setup func(fs *flag.FlagSet) func(ctx context.Context, client ufoV1.UFOServiceClient, args []string) (any, error)
+}
+
+var commands = []command{
+	{name: "create", args: "[flags]", usage: "создать наблюдение", setup: setupCreate},
+	{name: "get", args: "<uuid>", usage: "получить наблюдение", setup: setupGet},
+	{name: "list", args: "", usage: "получить все наблюдения", setup: setupList},
+	{name: "update", args: "[flags] <uuid>", usage: "частично обновить наблюдение", setup: setupUpdate},
+	{name: "delete", args: "<uuid>", usage: "мягко удалить наблюдение", setup: setupDelete},
+}
+
+// options общие флаги всех подкоманд.
+type options struct {
+	address string
+	timeout time.Duration
+	output  string
+	tls     tlsconf.Config
+}
+
+func (o *options) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.address, "addr", envOr("UFOCTL_ADDR", defaultAddress), "адрес gRPC сервера (или UFOCTL_ADDR)")
+	fs.DurationVar(&o.timeout, "timeout", defaultTimeout, "таймаут вызова")
+	fs.StringVar(&o.output, "output", outputTable, "формат вывода: table, json или yaml")
+	fs.StringVar(&o.tls.CAFile, "tls-ca", "", "путь к CA для проверки сервера (включает TLS)")
+	fs.StringVar(&o.tls.CertFile, "tls-cert", "", "путь к клиентскому сертификату для mTLS")
+	fs.StringVar(&o.tls.KeyFile, "tls-key", "", "путь к ключу клиентского сертификата")
+	fs.StringVar(&o.tls.ServerName, "tls-server-name", "localhost", "ожидаемое имя сервера в сертификате")
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "--help" || args[0] == "help" {
+		printUsage(stderr)
+		if len(args) == 0 {
+			return exitUsage
+		}
+		return exitOK
+	}
+
+	var cmd *command
+	for i := range commands {
+		if commands[i].name == args[0] {
+			cmd = &commands[i]
+		}
+	}
+	if cmd == nil {
+		fmt.Fprintf(stderr, "ufoctl: неизвестная команда %q\n\n", args[0])
+		printUsage(stderr)
+		return exitUsage
+	}
+
+	fs := flag.NewFlagSet("ufoctl "+cmd.name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Использование: ufoctl %s — %s\n\nФлаги:\n", strings.TrimSpace(cmd.name+" "+cmd.args), cmd.usage)
+		fs.PrintDefaults()
+	}
+
+	var opts options
+	opts.register(fs)
+	exec := cmd.setup(fs)
+
+	if err := fs.Parse(args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	printer, err := newPrinter(opts.output, stdout)
+	if err != nil {
+		fmt.Fprintf(stderr, "ufoctl: %v\n", err)
+		return exitUsage
+	}
+
+	conn, err := dial(opts)
+	if err != nil {
+		fmt.Fprintf(stderr, "ufoctl: %v\n", err)
+		return exitError
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), opts.timeout)
+	defer cancel()
+
+	result, err := exec(ctx, ufoV1.NewUFOServiceClient(conn), fs.Args())
+	if err != nil {
+		return reportError(stderr, fs, err)
+	}
+
+	if err := printer.print(result); err != nil {
+		fmt.Fprintf(stderr, "ufoctl: %v\n", err)
+		return exitError
+	}
+	return exitOK
+}
+
+// reportError печатает ошибку и возвращает код выхода для нее.
+func reportError(stderr io.Writer, fs *flag.FlagSet, err error) int {
+	if errors.Is(err, errUsage) {
+		fmt.Fprintf(stderr, "ufoctl: %v\n\n", err)
+		fs.Usage()
+		return exitUsage
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		fmt.Fprintf(stderr, "ufoctl: %v\n", err)
+		return exitError
+	}
+
+	fmt.Fprintf(stderr, "ufoctl: %s: %s\n", st.Code(), st.Message())
+	for _, v := range fieldViolations(st) {
+		fmt.Fprintf(stderr, "  - %s: %s\n", v.GetField(), v.GetDescription())
+	}
+	return exitStatusBase + int(st.Code())
+}
+
+func dial(opts options) (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	if opts.tls.Enabled() {
+		reloader, err := tlsconf.NewReloader(opts.tls)
+		if err != nil {
+			return nil, fmt.Errorf("load TLS config: %w", err)
+		}
+		creds = credentials.NewTLS(reloader.ClientConfig())
+	}
+
+	conn, err := grpc.NewClient(opts.address,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultServiceConfig(clientinterceptor.CLIServiceConfig),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("dial %s: %w", opts.address, err)
+	}
+	return conn, nil
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintf(w, "Использование: ufoctl <команда> [флаги] [аргументы]\n\nКоманды:\n")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-8s %s\n", c.name, c.usage)
+	}
+	fmt.Fprintf(w, "\nФлаги команды: ufoctl <команда> -h\n")
+	fmt.Fprintf(w, "Код выхода: 0 — успех, 1 — локальная ошибка, 2 — неверные аргументы, 10 + код gRPC — ошибка сервера.\n")
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	ufoV1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const testUUID = "6f1d2c3b-4a5e-4f60-8a7b-9c0d1e2f3a4b"
+
+// stubServer отвечает на Create эхом запроса, а на Get — заданной ошибкой.
+type stubServer struct {
+	ufoV1.UnimplementedUFOServiceServer
+
+	created *ufoV1.SightingInfo
+	getErr  error
+}
+
+func (s *stubServer) Create(_ context.Context, req *ufoV1.CreateRequest) (*ufoV1.CreateResponse, error) {
+	s.created = req.GetInfo()
+	return &ufoV1.CreateResponse{Uuid: testUUID}, nil
+}
+
+func (s *stubServer) Get(context.Context, *ufoV1.GetRequest) (*ufoV1.GetResponse, error) {
+	return nil, s.getErr
+}
+
+func startStub(t *testing.T, stub *stubServer) string {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer()
+	ufoV1.RegisterUFOServiceServer(s, stub)
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	return lis.Addr().String()
+}
+
+func TestReportErrorExitCodes(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "not found", err: status.Error(codes.NotFound, "no sighting"), want: 15},
+		{name: "invalid argument", err: status.Error(codes.InvalidArgument, "bad location"), want: 13},
+		{name: "failed precondition", err: status.Error(codes.FailedPrecondition, "already deleted"), want: 19},
+		{name: "deadline exceeded", err: status.Error(codes.DeadlineExceeded, "timeout"), want: 14},
+		{name: "unavailable", err: status.Error(codes.Unavailable, "connection refused"), want: 24},
+		{name: "usage", err: fmt.Errorf("%w: ожидается ровно один uuid", errUsage), want: exitUsage},
+		{name: "local error", err: errors.New("read patch.json: no such file"), want: exitError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := flag.NewFlagSet("ufoctl get", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+
+			if got := reportError(io.Discard, fs, tt.err); got != tt.want {
+				t.Errorf("exit code = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunUsage(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{name: "no command", args: nil, want: exitUsage},
+		{name: "help", args: []string{"help"}, want: exitOK},
+		{name: "command help", args: []string{"get", "-h"}, want: exitOK},
+		{name: "unknown command", args: []string{"purge"}, want: exitUsage},
+		{name: "unknown flag", args: []string{"list", "-verbose"}, want: exitUsage},
+		{name: "unknown output", args: []string{"list", "-output", "xml"}, want: exitUsage},
+		{name: "bad duration", args: []string{"create", "-duration", "long"}, want: exitUsage},
+		{name: "duration overflows int32", args: []string{"create", "-duration", "3000000000"}, want: exitUsage},
+		{name: "bad observed-at", args: []string{"create", "-observed-at", "yesterday"}, want: exitUsage},
+		{name: "bad sound", args: []string{"create", "-sound=maybe"}, want: exitUsage},
+		{name: "missing uuid", args: []string{"get"}, want: exitUsage},
+		{name: "extra uuid", args: []string{"delete", testUUID, testUUID}, want: exitUsage},
+		{name: "list arguments", args: []string{"list", "extra"}, want: exitUsage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := run(tt.args, io.Discard, io.Discard); got != tt.want {
+				t.Errorf("run(%q) = %d, want %d", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunCreateFlags(t *testing.T) {
+	stub := &stubServer{}
+	addr := startStub(t, stub)
+
+	var stdout bytes.Buffer
+	code := run([]string{
+		"create", "-addr", addr, "-output", "json",
+		"-location", "Roswell",
+		"-observed-at", "2024-01-02T15:04:05Z",
+		"-color", "green",
+		"-sound=false",
+		"-duration", "90",
+	}, &stdout, io.Discard)
+	if code != exitOK {
+		t.Fatalf("exit code = %d, want %d", code, exitOK)
+	}
+
+	want := &ufoV1.SightingInfo{
+		ObservedAt:      timestamppb.New(time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)),
+		Location:        "Roswell",
+		Color:           wrapperspb.String("green"),
+		Sound:           wrapperspb.Bool(false),
+		DurationSeconds: wrapperspb.Int32(90),
+	}
+	if !proto.Equal(stub.created, want) {
+		t.Errorf("created info = %v, want %v", stub.created, want)
+	}
+
+	var out struct {
+		UUID string `json:"uuid"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &out); err != nil || out.UUID != testUUID {
+		t.Errorf("output = %q, want uuid %s", stdout.String(), testUUID)
+	}
+}
+
+func TestRunUnsetFlagsStayUnset(t *testing.T) {
+	stub := &stubServer{}
+	addr := startStub(t, stub)
+
+	if code := run([]string{"create", "-addr", addr, "-location", "Roswell"}, io.Discard, io.Discard); code != exitOK {
+		t.Fatalf("exit code = %d, want %d", code, exitOK)
+	}
+	if stub.created.GetSound() != nil || stub.created.GetColor() != nil || stub.created.GetDurationSeconds() != nil {
+		t.Errorf("optional fields set without flags: %v", stub.created)
+	}
+}
+
+func TestRunServerStatusExitCode(t *testing.T) {
+	addr := startStub(t, &stubServer{getErr: status.Error(codes.NotFound, "no sighting")})
+
+	var stderr bytes.Buffer
+	if got := run([]string{"get", "-addr", addr, testUUID}, io.Discard, &stderr); got != exitStatusBase+int(codes.NotFound) {
+		t.Errorf("exit code = %d, want %d (stderr: %s)", got, exitStatusBase+int(codes.NotFound), stderr.String())
+	}
+}
+
+// TestRunUnavailableServer проверяет, что ufoctl не ждет дедлайна, когда
+// сервер недоступен, и завершается с 10 + UNAVAILABLE.
+func TestRunUnavailableServer(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := lis.Addr().String()
+	lis.Close()
+
+	start := time.Now()
+	got := run([]string{"get", "-addr", addr, "-timeout", "10s", testUUID}, io.Discard, io.Discard)
+	if want := exitStatusBase + int(codes.Unavailable); got != want {
+		t.Errorf("exit code = %d, want %d", got, want)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("run took %v, want to fail fast instead of waiting for the deadline", elapsed)
+	}
+}
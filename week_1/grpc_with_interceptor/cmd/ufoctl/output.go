@@ -0,0 +1,154 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	ufoV1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v3"
+)
+
+// Форматы вывода.
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// jsonOptions совпадают с именами полей в .proto, чтобы вывод был удобен для jq.
+var jsonOptions = protojson.MarshalOptions{UseProtoNames: true}
+
+type printer struct {
+	format string
+	w      io.Writer
+}
+
+func newPrinter(format string, w io.Writer) (*printer, error) {
+	switch format {
+	case outputTable, outputJSON, outputYAML:
+		return &printer{format: format, w: w}, nil
+	default:
+		return nil, fmt.Errorf("неизвестный формат вывода %q (table, json, yaml)", format)
+	}
+}
+
+func (p *printer) print(v any) error {
+	if p.format == outputTable {
+		return p.table(v)
+	}
+
+	data, err := toJSON(v)
+	if err != nil {
+		return err
+	}
+
+	if p.format == outputYAML {
+		// JSON — подмножество YAML: yaml.Node сохраняет порядок полей,
+		// остается сбросить стиль JSON (кавычки, скобки) на блочный YAML.
+		var node yaml.Node
+		if err := yaml.Unmarshal(data, &node); err != nil {
+			return err
+		}
+		blockStyle(&node)
+		enc := yaml.NewEncoder(p.w)
+		enc.SetIndent(2)
+		if err := enc.Encode(&node); err != nil {
+			return err
+		}
+		return enc.Close()
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		return err
+	}
+	buf.WriteByte('\n')
+	_, err = buf.WriteTo(p.w)
+	return err
+}
+
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, n := range node.Content {
+		blockStyle(n)
+	}
+}
+
+func toJSON(v any) ([]byte, error) {
+	if m, ok := v.(proto.Message); ok {
+		return jsonOptions.Marshal(m)
+	}
+	return json.Marshal(v)
+}
+
+func (p *printer) table(v any) error {
+	switch v := v.(type) {
+	case *ufoV1.CreateResponse:
+		// Только uuid: удобно для id=$(ufoctl create ...).
+		_, err := fmt.Fprintln(p.w, v.GetUuid())
+		return err
+	case *ufoV1.Sighting:
+		return p.sightings([]*ufoV1.Sighting{v})
+	case *ufoV1.GetAllResponse:
+		return p.sightings(v.GetSightings())
+	case *actionResult:
+		_, err := fmt.Fprintf(p.w, "%s\t%s\n", v.UUID, v.Status)
+		return err
+	default:
+		return fmt.Errorf("unsupported result type %T", v)
+	}
+}
+
+func (p *printer) sightings(sightings []*ufoV1.Sighting) error {
+	tw := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "UUID\tOBSERVED AT\tLOCATION\tCOLOR\tSOUND\tDURATION\tDELETED\tDESCRIPTION")
+	for _, s := range sightings {
+		info := s.GetInfo()
+
+		sound := "-"
+		if info.GetSound() != nil {
+			sound = strconv.FormatBool(info.GetSound().GetValue())
+		}
+		duration := "-"
+		if info.GetDurationSeconds() != nil {
+			duration = (time.Duration(info.GetDurationSeconds().GetValue()) * time.Second).String()
+		}
+		color := "-"
+		if info.GetColor() != nil {
+			color = info.GetColor().GetValue()
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			s.GetUuid(), formatTime(info.GetObservedAt()), info.GetLocation(), color, sound, duration,
+			formatTime(s.GetDeletedAt()), info.GetDescription())
+	}
+	return tw.Flush()
+}
+
+func formatTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return "-"
+	}
+	return ts.AsTime().Local().Format(time.RFC3339)
+}
+
+// fieldViolations извлекает нарушения валидации из деталей статуса.
+func fieldViolations(st *status.Status) []*errdetails.BadRequest_FieldViolation {
+	var result []*errdetails.BadRequest_FieldViolation
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			result = append(result, br.GetFieldViolations()...)
+		}
+	}
+	return result
+}
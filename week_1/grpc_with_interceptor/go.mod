@@ -3,18 +3,29 @@ module github.com/mbakhodurov/examples/week_1/grpc_with_interceptor
 go 1.25.4
 
 require (
+	connectrpc.com/vanguard v0.3.0
 	github.com/brianvoe/gofakeit v3.18.0+incompatible
 	github.com/envoyproxy/protoc-gen-validate v1.2.1
+	github.com/google/cel-go v0.26.1
 	github.com/google/uuid v1.6.0
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.27.3
+	github.com/swaggest/swgui v1.8.5
+	golang.org/x/net v0.46.1-0.20251013234738-63d1a5100f82
+	golang.org/x/sync v0.18.0
 	google.golang.org/genproto/googleapis/api v0.0.0-20251124214823-79d6a2a48846
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20251111163417-95abcf5c77ba
 	google.golang.org/grpc v1.77.0
 	google.golang.org/protobuf v1.36.10
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
-	golang.org/x/net v0.46.1-0.20251013234738-63d1a5100f82 // indirect
+	cel.dev/expr v0.24.0 // indirect
+	connectrpc.com/connect v1.16.2 // indirect
+	github.com/antlr4-go/antlr/v4 v4.13.0 // indirect
+	github.com/stoewer/go-strcase v1.2.0 // indirect
+	github.com/vearutop/statigz v1.4.0 // indirect
+	golang.org/x/exp v0.0.0-20230515195305-f3d0a9c9a5cc // indirect
 	golang.org/x/sys v0.37.0 // indirect
 	golang.org/x/text v0.30.0 // indirect
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20251111163417-95abcf5c77ba // indirect
 )
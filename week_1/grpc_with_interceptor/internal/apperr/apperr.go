@@ -0,0 +1,203 @@
+package apperr
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// Домены ошибок (errdetails.ErrorInfo.Domain) по версиям API: совпадают с
+// proto пакетом сервиса, который вернул ошибку.
+const (
+	DomainV1 = "ufo.v1"
+	DomainV2 = "ufo.v2"
+)
+
+// Типы ресурсов для errdetails.ResourceInfo без пакета: пакетом служит домен
+// ошибки (ufo.v1.Sighting, ufo.v2.Sighting).
+const (
+	ResourceSighting     = "Sighting"
+	ResourceSubscription = "Subscription"
+)
+
+// Kind вид доменной ошибки.
+type Kind int
+
+const (
+	KindNotFound Kind = iota + 1
+	KindAlreadyDeleted
+	KindConflict
+	KindValidation
+)
+
+// Причины ошибок (ErrorInfo.Reason), стабильные для клиентов API.
+const (
+	ReasonNotFound         = "SIGHTING_NOT_FOUND"
+	ReasonAlreadyDeleted   = "SIGHTING_ALREADY_DELETED"
+	ReasonConflict         = "SIGHTING_CONFLICT"
+	ReasonValidationFailed = "VALIDATION_FAILED"
+
+	ReasonSubscriptionNotFound = "SUBSCRIPTION_NOT_FOUND"
+)
+
+// Сигнальные ошибки для сравнения через errors.Is.
+var (
+	ErrNotFound       = &Error{Kind: KindNotFound}
+	ErrAlreadyDeleted = &Error{Kind: KindAlreadyDeleted}
+	ErrConflict       = &Error{Kind: KindConflict}
+	ErrValidation     = &Error{Kind: KindValidation}
+)
+
+// Error доменная ошибка сервиса. Реализует GRPCStatus(), поэтому gRPC сервер
+// сам преобразует ее в статус с нужным кодом и деталями.
+type Error struct {
+	Kind   Kind
+	Reason string
+	// Domain домен ErrorInfo, по умолчанию DomainV1. Ошибки создаются без
+	// знания версии API, домен проставляет WithDomain.
+	Domain string
+	// Resource тип ресурса для ResourceInfo, по умолчанию ResourceSighting.
+	Resource   string
+	Message    string
+	ResourceID string
+	Metadata   map[string]string
+	Violations []*errdetails.BadRequest_FieldViolation
+}
+
+// NotFound возвращает ошибку отсутствия наблюдения с указанным uuid.
+func NotFound(uuid string) *Error {
+	return &Error{
+		Kind:       KindNotFound,
+		Reason:     ReasonNotFound,
+		Message:    fmt.Sprintf("sighting %s not found", uuid),
+		ResourceID: uuid,
+		Metadata:   map[string]string{"uuid": uuid},
+	}
+}
+
+// SubscriptionNotFound возвращает ошибку отсутствия webhook подписки с указанным id.
+func SubscriptionNotFound(id string) *Error {
+	return &Error{
+		Kind:       KindNotFound,
+		Reason:     ReasonSubscriptionNotFound,
+		Resource:   ResourceSubscription,
+		Message:    fmt.Sprintf("subscription %s not found", id),
+		ResourceID: id,
+		Metadata:   map[string]string{"id": id},
+	}
+}
+
+// AlreadyDeleted возвращает ошибку операции над удаленным наблюдением.
+func AlreadyDeleted(uuid string) *Error {
+	return &Error{
+		Kind:       KindAlreadyDeleted,
+		Reason:     ReasonAlreadyDeleted,
+		Message:    fmt.Sprintf("sighting %s already deleted", uuid),
+		ResourceID: uuid,
+		Metadata:   map[string]string{"uuid": uuid},
+	}
+}
+
+// Conflict возвращает ошибку конфликта состояния наблюдения.
+func Conflict(uuid, message string) *Error {
+	return &Error{
+		Kind:       KindConflict,
+		Reason:     ReasonConflict,
+		Message:    fmt.Sprintf("sighting %s: %s", uuid, message),
+		ResourceID: uuid,
+		Metadata:   map[string]string{"uuid": uuid},
+	}
+}
+
+// Validation возвращает ошибку валидации запроса с нарушениями по полям.
+func Validation(message string, violations ...*errdetails.BadRequest_FieldViolation) *Error {
+	return &Error{
+		Kind:       KindValidation,
+		Reason:     ReasonValidationFailed,
+		Message:    message,
+		Violations: violations,
+	}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Is сравнивает ошибки по виду, что позволяет писать errors.Is(err, apperr.ErrNotFound).
+func (e *Error) Is(target error) bool {
+	var t *Error
+	if !errors.As(target, &t) {
+		return false
+	}
+	return t.Kind == e.Kind
+}
+
+// Code возвращает код gRPC для вида ошибки.
+func (e *Error) Code() codes.Code {
+	switch e.Kind {
+	case KindNotFound:
+		return codes.NotFound
+	case KindAlreadyDeleted:
+		return codes.FailedPrecondition
+	case KindConflict:
+		return codes.AlreadyExists
+	case KindValidation:
+		return codes.InvalidArgument
+	default:
+		return codes.Unknown
+	}
+}
+
+// WithDomain возвращает err с доменом domain, если в цепочке err есть *Error.
+// Исходная ошибка не меняется: возвращается копия *Error без оберток, как
+// ее и увидит клиент. Остальные ошибки возвращаются как есть.
+func WithDomain(err error, domain string) error {
+	var e *Error
+	if !errors.As(err, &e) || e.Domain == domain {
+		return err
+	}
+	c := *e
+	c.Domain = domain
+	return &c
+}
+
+// GRPCStatus собирает gRPC статус с деталями ErrorInfo, ResourceInfo и BadRequest.
+func (e *Error) GRPCStatus() *status.Status {
+	st := status.New(e.Code(), e.Message)
+
+	domain := e.Domain
+	if domain == "" {
+		domain = DomainV1
+	}
+	details := []protoadapt.MessageV1{
+		&errdetails.ErrorInfo{
+			Reason:   e.Reason,
+			Domain:   domain,
+			Metadata: e.Metadata,
+		},
+	}
+	if e.ResourceID != "" {
+		resource := e.Resource
+		if resource == "" {
+			resource = ResourceSighting
+		}
+		details = append(details, &errdetails.ResourceInfo{
+			ResourceType: domain + "." + resource,
+			ResourceName: e.ResourceID,
+			Description:  e.Message,
+		})
+	}
+	if len(e.Violations) > 0 {
+		details = append(details, &errdetails.BadRequest{FieldViolations: e.Violations})
+	}
+
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
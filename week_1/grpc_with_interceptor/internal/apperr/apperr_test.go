@@ -0,0 +1,174 @@
+package apperr
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestErrorStatus(t *testing.T) {
+	violation := &errdetails.BadRequest_FieldViolation{Field: "info.location", Description: "value length must be at least 1 runes"}
+
+	tests := []struct {
+		name         string
+		err          *Error
+		wantCode     codes.Code
+		wantHTTP     int
+		wantSentinel error
+		wantInfo     *errdetails.ErrorInfo
+		// wantResource nil — деталь ResourceInfo не ожидается.
+		wantResource *errdetails.ResourceInfo
+		wantBadReq   *errdetails.BadRequest
+	}{
+		{
+			name:         "not found",
+			err:          NotFound("42"),
+			wantCode:     codes.NotFound,
+			wantHTTP:     http.StatusNotFound,
+			wantSentinel: ErrNotFound,
+			wantInfo:     &errdetails.ErrorInfo{Reason: ReasonNotFound, Domain: DomainV1, Metadata: map[string]string{"uuid": "42"}},
+			wantResource: &errdetails.ResourceInfo{ResourceType: "ufo.v1.Sighting", ResourceName: "42", Description: "sighting 42 not found"},
+		},
+		{
+			name:         "subscription not found",
+			err:          SubscriptionNotFound("7"),
+			wantCode:     codes.NotFound,
+			wantHTTP:     http.StatusNotFound,
+			wantSentinel: ErrNotFound,
+			wantInfo:     &errdetails.ErrorInfo{Reason: ReasonSubscriptionNotFound, Domain: DomainV1, Metadata: map[string]string{"id": "7"}},
+			wantResource: &errdetails.ResourceInfo{ResourceType: "ufo.v1.Subscription", ResourceName: "7", Description: "subscription 7 not found"},
+		},
+		{
+			name:         "already deleted",
+			err:          AlreadyDeleted("42"),
+			wantCode:     codes.FailedPrecondition,
+			wantHTTP:     http.StatusBadRequest,
+			wantSentinel: ErrAlreadyDeleted,
+			wantInfo:     &errdetails.ErrorInfo{Reason: ReasonAlreadyDeleted, Domain: DomainV1, Metadata: map[string]string{"uuid": "42"}},
+			wantResource: &errdetails.ResourceInfo{ResourceType: "ufo.v1.Sighting", ResourceName: "42", Description: "sighting 42 already deleted"},
+		},
+		{
+			name:         "conflict",
+			err:          Conflict("42", "version mismatch"),
+			wantCode:     codes.AlreadyExists,
+			wantHTTP:     http.StatusConflict,
+			wantSentinel: ErrConflict,
+			wantInfo:     &errdetails.ErrorInfo{Reason: ReasonConflict, Domain: DomainV1, Metadata: map[string]string{"uuid": "42"}},
+			wantResource: &errdetails.ResourceInfo{ResourceType: "ufo.v1.Sighting", ResourceName: "42", Description: "sighting 42: version mismatch"},
+		},
+		{
+			name:         "validation",
+			err:          Validation("validation error", violation),
+			wantCode:     codes.InvalidArgument,
+			wantHTTP:     http.StatusBadRequest,
+			wantSentinel: ErrValidation,
+			wantInfo:     &errdetails.ErrorInfo{Reason: ReasonValidationFailed, Domain: DomainV1},
+			wantBadReq:   &errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{violation}},
+		},
+		{
+			name:         "not found in v2",
+			err:          WithDomain(NotFound("42"), DomainV2).(*Error),
+			wantCode:     codes.NotFound,
+			wantHTTP:     http.StatusNotFound,
+			wantSentinel: ErrNotFound,
+			wantInfo:     &errdetails.ErrorInfo{Reason: ReasonNotFound, Domain: DomainV2, Metadata: map[string]string{"uuid": "42"}},
+			wantResource: &errdetails.ResourceInfo{ResourceType: "ufo.v2.Sighting", ResourceName: "42", Description: "sighting 42 not found"},
+		},
+		{
+			name:     "unknown kind",
+			err:      &Error{Message: "boom"},
+			wantCode: codes.Unknown,
+			wantHTTP: http.StatusInternalServerError,
+			wantInfo: &errdetails.ErrorInfo{Domain: DomainV1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Статус должен находиться и через обертку fmt.Errorf.
+			st, ok := status.FromError(fmt.Errorf("wrapped: %w", tt.err))
+			if !ok {
+				t.Fatal("status.FromError did not find GRPCStatus")
+			}
+			if st.Code() != tt.wantCode {
+				t.Errorf("code = %v, want %v", st.Code(), tt.wantCode)
+			}
+			if got := runtime.HTTPStatusFromCode(st.Code()); got != tt.wantHTTP {
+				t.Errorf("HTTP status = %d, want %d", got, tt.wantHTTP)
+			}
+			if tt.wantSentinel != nil && !errors.Is(tt.err, tt.wantSentinel) {
+				t.Errorf("errors.Is(%v, sentinel) = false", tt.err)
+			}
+
+			var info *errdetails.ErrorInfo
+			var resource *errdetails.ResourceInfo
+			var badReq *errdetails.BadRequest
+			for _, d := range st.Details() {
+				switch d := d.(type) {
+				case *errdetails.ErrorInfo:
+					info = d
+				case *errdetails.ResourceInfo:
+					resource = d
+				case *errdetails.BadRequest:
+					badReq = d
+				}
+			}
+			if !proto.Equal(info, tt.wantInfo) {
+				t.Errorf("ErrorInfo = %v, want %v", info, tt.wantInfo)
+			}
+			if !proto.Equal(resource, tt.wantResource) {
+				t.Errorf("ResourceInfo = %v, want %v", resource, tt.wantResource)
+			}
+			if !proto.Equal(badReq, tt.wantBadReq) {
+				t.Errorf("BadRequest = %v, want %v", badReq, tt.wantBadReq)
+			}
+		})
+	}
+}
+
+func TestErrorIsComparesKind(t *testing.T) {
+	err := fmt.Errorf("update: %w", NotFound("42"))
+
+	if !errors.Is(err, ErrNotFound) {
+		t.Error("errors.Is(NotFound, ErrNotFound) = false")
+	}
+	if errors.Is(err, ErrAlreadyDeleted) {
+		t.Error("errors.Is(NotFound, ErrAlreadyDeleted) = true")
+	}
+	if errors.Is(err, errors.New("sighting 42 not found")) {
+		t.Error("errors.Is matched a plain error with the same text")
+	}
+}
+
+func TestWithDomain(t *testing.T) {
+	orig := Validation("validation error")
+	wrapped := fmt.Errorf("create: %w", orig)
+
+	got := WithDomain(wrapped, DomainV2)
+	var e *Error
+	if !errors.As(got, &e) || e.Domain != DomainV2 {
+		t.Fatalf("WithDomain() = %#v, want *Error with domain %s", got, DomainV2)
+	}
+	if !errors.Is(got, ErrValidation) {
+		t.Error("WithDomain lost the error kind")
+	}
+	if orig.Domain != "" {
+		t.Errorf("WithDomain changed the original error: domain %q", orig.Domain)
+	}
+
+	// Ошибки не из apperr возвращаются без изменений.
+	plain := status.Error(codes.Unavailable, "down")
+	if got := WithDomain(plain, DomainV2); got != plain {
+		t.Errorf("WithDomain(status error) = %v, want the same error", got)
+	}
+	if got := WithDomain(nil, DomainV2); got != nil {
+		t.Errorf("WithDomain(nil) = %v, want nil", got)
+	}
+}
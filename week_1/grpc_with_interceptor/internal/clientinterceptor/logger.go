@@ -0,0 +1,38 @@
+package clientinterceptor
+
+import (
+	"context"
+	"log"
+	"path"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// LoggerInterceptor создает клиентский унарный интерцептор, который логирует
+// результат и время выполнения каждого вызова.
+func LoggerInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		name := path.Base(method)
+		startTime := time.Now()
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		duration := time.Since(startTime)
+		if err != nil {
+			log.Printf("❌ gRPC call %s failed with code %s (took: %v)\n", name, status.Code(err), duration)
+		} else {
+			log.Printf("✅ gRPC call %s succeeded (took: %v)\n", name, duration)
+		}
+
+		return err
+	}
+}
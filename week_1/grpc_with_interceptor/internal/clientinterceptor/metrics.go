@@ -0,0 +1,105 @@
+package clientinterceptor
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// methodStats накопленная статистика вызовов одного метода.
+type methodStats struct {
+	calls    int64
+	codes    map[string]int64
+	total    time.Duration
+	maxTaken time.Duration
+}
+
+// Metrics собирает клиентскую статистику вызовов по методам и кодам ответа.
+type Metrics struct {
+	mu      sync.Mutex
+	methods map[string]*methodStats
+}
+
+// NewMetrics создает пустой набор клиентских метрик.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		methods: make(map[string]*methodStats),
+	}
+}
+
+// Interceptor создает клиентский унарный интерцептор, который учитывает
+// каждый вызов в метриках.
+func (m *Metrics) Interceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		startTime := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		m.observe(path.Base(method), status.Code(err).String(), time.Since(startTime))
+
+		return err
+	}
+}
+
+func (m *Metrics) observe(method, code string, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats, ok := m.methods[method]
+	if !ok {
+		stats = &methodStats{codes: make(map[string]int64)}
+		m.methods[method] = stats
+	}
+
+	stats.calls++
+	stats.codes[code]++
+	stats.total += duration
+	if duration > stats.maxTaken {
+		stats.maxTaken = duration
+	}
+}
+
+// Report возвращает текстовую сводку метрик по всем методам.
+func (m *Metrics) Report() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	names := make([]string, 0, len(m.methods))
+	for name := range m.methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		stats := m.methods[name]
+
+		codeNames := make([]string, 0, len(stats.codes))
+		for code := range stats.codes {
+			codeNames = append(codeNames, code)
+		}
+		sort.Strings(codeNames)
+
+		parts := make([]string, 0, len(codeNames))
+		for _, code := range codeNames {
+			parts = append(parts, fmt.Sprintf("%s=%d", code, stats.codes[code]))
+		}
+
+		fmt.Fprintf(&b, "%s: calls=%d avg=%v max=%v codes[%s]\n",
+			name, stats.calls, stats.total/time.Duration(stats.calls), stats.maxTaken, strings.Join(parts, " "))
+	}
+
+	return b.String()
+}
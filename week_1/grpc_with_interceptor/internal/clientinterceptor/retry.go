@@ -0,0 +1,114 @@
+package clientinterceptor
+
+import (
+	"context"
+	"log"
+	"math/rand/v2"
+	"path"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryConfig параметры повторов клиентских вызовов.
+type RetryConfig struct {
+	// MaxAttempts общее число попыток, включая первую.
+	MaxAttempts int
+	// InitialBackoff пауза перед первым повтором.
+	InitialBackoff time.Duration
+	// MaxBackoff верхняя граница паузы между повторами.
+	MaxBackoff time.Duration
+	// Multiplier множитель экспоненциального роста паузы.
+	Multiplier float64
+	// Jitter доля случайного разброса паузы (0.2 означает ±20%).
+	Jitter float64
+	// RetryableCodes коды ответа, при которых вызов повторяется.
+	RetryableCodes []codes.Code
+	// IdempotentMethods методы, которые безопасно повторять.
+	IdempotentMethods []string
+}
+
+// DefaultRetryConfig повторяет идемпотентные методы UFOService при
+// временной недоступности сервера. Delete не идемпотентен: повтор после
+// потерянного ответа вернет FailedPrecondition (наблюдение уже удалено).
+var DefaultRetryConfig = RetryConfig{
+	MaxAttempts:       3,
+	InitialBackoff:    100 * time.Millisecond,
+	MaxBackoff:        2 * time.Second,
+	Multiplier:        2,
+	Jitter:            0.2,
+	RetryableCodes:    []codes.Code{codes.Unavailable, codes.ResourceExhausted},
+	IdempotentMethods: []string{"Get", "GetAll", "Update"},
+}
+
+// RetryInterceptor создает клиентский унарный интерцептор, который повторяет
+// идемпотентные вызовы с экспоненциальной паузой и джиттером. Create и
+// Delete не повторяются: повтор создал бы дубликат наблюдения или вернул
+// ошибку повторного удаления. Интерцептор нужен клиентам без service config
+// с retryPolicy: вместе с ServiceConfig его не подключают, чтобы попытки
+// двух слоев не перемножались. Если ctx завершается во время паузы, вызов
+// возвращает статус Canceled или DeadlineExceeded.
+func RetryInterceptor(cfg RetryConfig) grpc.UnaryClientInterceptor {
+	idempotent := make(map[string]struct{}, len(cfg.IdempotentMethods))
+	for _, m := range cfg.IdempotentMethods {
+		idempotent[m] = struct{}{}
+	}
+	retryable := make(map[codes.Code]struct{}, len(cfg.RetryableCodes))
+	for _, c := range cfg.RetryableCodes {
+		retryable[c] = struct{}{}
+	}
+
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if _, ok := idempotent[path.Base(method)]; !ok || cfg.MaxAttempts <= 1 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		var err error
+		for attempt := 1; ; attempt++ {
+			err = invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil {
+				return nil
+			}
+
+			if _, ok := retryable[status.Code(err)]; !ok || attempt >= cfg.MaxAttempts {
+				return err
+			}
+
+			delay := cfg.backoff(attempt)
+			log.Printf("🔁 Retrying %s after %v (attempt %d/%d): %v\n",
+				path.Base(method), delay, attempt+1, cfg.MaxAttempts, err)
+
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return status.FromContextError(ctx.Err()).Err()
+			case <-timer.C:
+			}
+		}
+	}
+}
+
+// backoff вычисляет паузу перед повтором номер attempt (начиная с 1).
+func (c RetryConfig) backoff(attempt int) time.Duration {
+	delay := float64(c.InitialBackoff)
+	for i := 1; i < attempt; i++ {
+		delay *= c.Multiplier
+	}
+	if c.MaxBackoff > 0 && delay > float64(c.MaxBackoff) {
+		delay = float64(c.MaxBackoff)
+	}
+	if c.Jitter > 0 {
+		delay *= 1 + c.Jitter*(2*rand.Float64()-1)
+	}
+	return time.Duration(delay)
+}
@@ -0,0 +1,157 @@
+package clientinterceptor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// scriptedInvoker возвращает ошибки из errs по очереди, затем nil, и считает вызовы.
+type scriptedInvoker struct {
+	errs  []error
+	calls int
+}
+
+func (s *scriptedInvoker) invoke(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+	s.calls++
+	if s.calls <= len(s.errs) {
+		return s.errs[s.calls-1]
+	}
+	return nil
+}
+
+// fastRetry DefaultRetryConfig с короткими паузами для тестов.
+func fastRetry() RetryConfig {
+	cfg := DefaultRetryConfig
+	cfg.InitialBackoff = time.Millisecond
+	cfg.MaxBackoff = time.Millisecond
+	return cfg
+}
+
+func TestRetryInterceptor(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+	exhausted := status.Error(codes.ResourceExhausted, "too many requests")
+	notFound := status.Error(codes.NotFound, "not found")
+
+	tests := []struct {
+		name      string
+		method    string
+		errs      []error
+		wantCalls int
+		wantCode  codes.Code
+	}{
+		{name: "success", method: "Get", wantCalls: 1},
+		{name: "retry until success", method: "Get", errs: []error{unavailable, exhausted}, wantCalls: 3},
+		{name: "stops at max attempts", method: "GetAll", errs: []error{unavailable, unavailable, unavailable, unavailable}, wantCalls: 3, wantCode: codes.Unavailable},
+		{name: "non-retryable code", method: "Update", errs: []error{notFound}, wantCalls: 1, wantCode: codes.NotFound},
+		{name: "create is not retried", method: "Create", errs: []error{unavailable}, wantCalls: 1, wantCode: codes.Unavailable},
+		{name: "delete is not retried", method: "Delete", errs: []error{unavailable}, wantCalls: 1, wantCode: codes.Unavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := &scriptedInvoker{errs: tt.errs}
+			err := RetryInterceptor(fastRetry())(context.Background(), "/ufo.v1.UFOService/"+tt.method, nil, nil, nil, inv.invoke)
+			if inv.calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", inv.calls, tt.wantCalls)
+			}
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("code = %v, want %v", got, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestRetryInterceptorMaxAttempts(t *testing.T) {
+	for _, maxAttempts := range []int{0, 1, 2, 5} {
+		cfg := fastRetry()
+		cfg.MaxAttempts = maxAttempts
+
+		inv := &scriptedInvoker{errs: make([]error, 10)}
+		for i := range inv.errs {
+			inv.errs[i] = status.Error(codes.Unavailable, "down")
+		}
+		_ = RetryInterceptor(cfg)(context.Background(), "/ufo.v1.UFOService/Get", nil, nil, nil, inv.invoke)
+
+		want := max(maxAttempts, 1)
+		if inv.calls != want {
+			t.Errorf("MaxAttempts %d: calls = %d, want %d", maxAttempts, inv.calls, want)
+		}
+	}
+}
+
+func TestRetryInterceptorContextEndsDuringBackoff(t *testing.T) {
+	cfg := DefaultRetryConfig
+	cfg.InitialBackoff = time.Hour
+	cfg.Jitter = 0
+
+	tests := []struct {
+		name     string
+		ctx      func() (context.Context, context.CancelFunc)
+		wantCode codes.Code
+	}{
+		{
+			name: "canceled",
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				time.AfterFunc(10*time.Millisecond, cancel)
+				return ctx, cancel
+			},
+			wantCode: codes.Canceled,
+		},
+		{
+			name: "deadline",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 10*time.Millisecond)
+			},
+			wantCode: codes.DeadlineExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := tt.ctx()
+			defer cancel()
+
+			inv := &scriptedInvoker{errs: []error{status.Error(codes.Unavailable, "down")}}
+			err := RetryInterceptor(cfg)(ctx, "/ufo.v1.UFOService/Get", nil, nil, nil, inv.invoke)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("code = %v, want %v (err: %v)", got, tt.wantCode, err)
+			}
+			if inv.calls != 1 {
+				t.Errorf("calls = %d, want 1", inv.calls)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	cfg := RetryConfig{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+	}
+
+	want := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second}
+	for i, w := range want {
+		if got := cfg.backoff(i + 1); got != w {
+			t.Errorf("backoff(%d) = %v, want %v", i+1, got, w)
+		}
+	}
+
+	// С джиттером пауза остается в пределах ±Jitter от расчетной.
+	cfg.Jitter = 0.2
+	for attempt := 1; attempt <= 5; attempt++ {
+		base := want[attempt-1]
+		lo, hi := time.Duration(float64(base)*0.8), time.Duration(float64(base)*1.2)
+		for range 200 {
+			if got := cfg.backoff(attempt); got < lo || got > hi {
+				t.Fatalf("backoff(%d) = %v, want within [%v, %v]", attempt, got, lo, hi)
+			}
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package clientinterceptor
+
+// ServiceConfig gRPC service config для UFOService: вызовы ждут готовности
+// соединения в пределах дедлайна, а идемпотентные методы повторяет встроенная
+// политика gRPC (retryPolicy). Это единственный слой повторов клиента:
+// RetryInterceptor вместе с ServiceConfig не подключается, иначе попытки двух
+// слоев перемножаются. Create и Delete не повторяются: повтор создал бы
+// дубликат наблюдения или вернул ошибку повторного удаления.
+const ServiceConfig = `{
+  "methodConfig": [
+    {
+      "name": [
+        {"service": "ufo.v1.UFOService", "method": "Get"},
+        {"service": "ufo.v1.UFOService", "method": "GetAll"},
+        {"service": "ufo.v1.UFOService", "method": "Update"}
+      ],
+      "waitForReady": true,
+      "retryPolicy": {
+        "maxAttempts": 3,
+        "initialBackoff": "0.1s",
+        "maxBackoff": "2s",
+        "backoffMultiplier": 2,
+        "retryableStatusCodes": ["UNAVAILABLE", "RESOURCE_EXHAUSTED"]
+      }
+    },
+    {
+      "name": [{"service": "ufo.v1.UFOService"}],
+      "waitForReady": true
+    }
+  ]
+}`
+
+// CLIServiceConfig service config для неинтерактивных клиентов (ufoctl). Без
+// waitForReady вызов к недоступному серверу сразу завершается UNAVAILABLE, а
+// не ждет дедлайна. Идемпотентные методы повторяет встроенная политика gRPC:
+// RetryInterceptor в CLI не подключается, поэтому слой повторов один.
+const CLIServiceConfig = `{
+  "methodConfig": [
+    {
+      "name": [
+        {"service": "ufo.v1.UFOService", "method": "Get"},
+        {"service": "ufo.v1.UFOService", "method": "GetAll"},
+        {"service": "ufo.v1.UFOService", "method": "Update"}
+      ],
+      "retryPolicy": {
+        "maxAttempts": 3,
+        "initialBackoff": "0.1s",
+        "maxBackoff": "1s",
+        "backoffMultiplier": 2,
+        "retryableStatusCodes": ["UNAVAILABLE"]
+      }
+    }
+  ]
+}`
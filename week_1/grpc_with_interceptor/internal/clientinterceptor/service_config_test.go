@@ -0,0 +1,86 @@
+package clientinterceptor
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// unavailableServer отвечает UNAVAILABLE на каждый вызов и считает их по методам.
+type unavailableServer struct {
+	ufo_v1.UnimplementedUFOServiceServer
+
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (s *unavailableServer) fail(method string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls[method]++
+	return status.Error(codes.Unavailable, "try later")
+}
+
+func (s *unavailableServer) Get(context.Context, *ufo_v1.GetRequest) (*ufo_v1.GetResponse, error) {
+	return nil, s.fail("Get")
+}
+
+func (s *unavailableServer) Create(context.Context, *ufo_v1.CreateRequest) (*ufo_v1.CreateResponse, error) {
+	return nil, s.fail("Create")
+}
+
+func (s *unavailableServer) Delete(context.Context, *ufo_v1.DeleteRequest) (*emptypb.Empty, error) {
+	return nil, s.fail("Delete")
+}
+
+// TestServiceConfigRetries проверяет retryPolicy из ServiceConfig: повторяются
+// только идемпотентные методы, и не больше maxAttempts раз.
+func TestServiceConfigRetries(t *testing.T) {
+	srv := &unavailableServer{calls: map[string]int{}}
+	s := grpc.NewServer()
+	ufo_v1.RegisterUFOServiceServer(s, srv)
+
+	lis := bufconn.Listen(1 << 20)
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(ServiceConfig),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	client := ufo_v1.NewUFOServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err = client.Get(ctx, &ufo_v1.GetRequest{Uuid: "42"})
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("Get error = %v, want Unavailable", err)
+	}
+	_, _ = client.Create(ctx, &ufo_v1.CreateRequest{})
+	_, _ = client.Delete(ctx, &ufo_v1.DeleteRequest{Uuid: "42"})
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	want := map[string]int{"Get": 3, "Create": 1, "Delete": 1}
+	for method, n := range want {
+		if srv.calls[method] != n {
+			t.Errorf("%s called %d times, want %d", method, srv.calls[method], n)
+		}
+	}
+}
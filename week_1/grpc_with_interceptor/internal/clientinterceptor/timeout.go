@@ -0,0 +1,49 @@
+package clientinterceptor
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// DefaultTimeouts таймауты по умолчанию для методов UFOService.
+var DefaultTimeouts = map[string]time.Duration{
+	"Create": 3 * time.Second,
+	"Get":    2 * time.Second,
+	"GetAll": 5 * time.Second,
+	"Update": 3 * time.Second,
+	"Delete": 3 * time.Second,
+}
+
+// TimeoutInterceptor создает клиентский унарный интерцептор, который
+// выставляет дедлайн вызова, если вызывающий код его не задал. Таймаут берется
+// из timeouts по имени метода, для остальных методов используется fallback.
+func TimeoutInterceptor(timeouts map[string]time.Duration, fallback time.Duration) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if _, ok := ctx.Deadline(); ok {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		timeout, ok := timeouts[path.Base(method)]
+		if !ok {
+			timeout = fallback
+		}
+		if timeout <= 0 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
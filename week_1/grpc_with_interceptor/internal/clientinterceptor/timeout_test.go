@@ -0,0 +1,73 @@
+package clientinterceptor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+func TestTimeoutInterceptor(t *testing.T) {
+	timeouts := map[string]time.Duration{
+		"Get":    2 * time.Second,
+		"GetAll": 5 * time.Second,
+		"Delete": 0,
+	}
+
+	tests := []struct {
+		name     string
+		method   string
+		ctx      func() (context.Context, context.CancelFunc)
+		fallback time.Duration
+		// want ожидаемый остаток до дедлайна; 0 — дедлайна нет.
+		want time.Duration
+	}{
+		{name: "per-method timeout", method: "Get", fallback: time.Second, want: 2 * time.Second},
+		{name: "another method", method: "GetAll", fallback: time.Second, want: 5 * time.Second},
+		{name: "fallback", method: "Create", fallback: time.Second, want: time.Second},
+		{name: "zero timeout", method: "Delete", fallback: time.Second},
+		{name: "no fallback", method: "Create"},
+		{
+			name:     "caller deadline wins",
+			method:   "GetAll",
+			fallback: time.Second,
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 30*time.Second)
+			},
+			want: 30 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.Background(), context.CancelFunc(func() {})
+			if tt.ctx != nil {
+				ctx, cancel = tt.ctx()
+			}
+			defer cancel()
+
+			var remaining time.Duration
+			invoker := func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+				if deadline, ok := ctx.Deadline(); ok {
+					remaining = time.Until(deadline)
+				}
+				return nil
+			}
+
+			err := TimeoutInterceptor(timeouts, tt.fallback)(ctx, "/ufo.v1.UFOService/"+tt.method, nil, nil, nil, invoker)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.want == 0 {
+				if remaining != 0 {
+					t.Errorf("deadline in %v, want none", remaining)
+				}
+				return
+			}
+			if remaining > tt.want || remaining < tt.want-time.Second {
+				t.Errorf("deadline in %v, want about %v", remaining, tt.want)
+			}
+		})
+	}
+}
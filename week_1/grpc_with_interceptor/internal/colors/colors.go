@@ -0,0 +1,213 @@
+package colors
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+)
+
+// names названия цветов на английском и основы русских прилагательных
+// (окончания отбрасывает stem), сведенные к палитре.
+var names = map[string]ufo_v1.Color{
+	"red":     ufo_v1.Color_COLOR_RED,
+	"crimson": ufo_v1.Color_COLOR_RED,
+	"scarlet": ufo_v1.Color_COLOR_RED,
+	"orange":  ufo_v1.Color_COLOR_ORANGE,
+	"amber":   ufo_v1.Color_COLOR_ORANGE,
+	"yellow":  ufo_v1.Color_COLOR_YELLOW,
+	"gold":    ufo_v1.Color_COLOR_YELLOW,
+	"golden":  ufo_v1.Color_COLOR_YELLOW,
+	"green":   ufo_v1.Color_COLOR_GREEN,
+	"lime":    ufo_v1.Color_COLOR_GREEN,
+	"olive":   ufo_v1.Color_COLOR_GREEN,
+	"cyan":    ufo_v1.Color_COLOR_CYAN,
+	"aqua":    ufo_v1.Color_COLOR_CYAN,
+	"teal":    ufo_v1.Color_COLOR_CYAN,
+	"blue":    ufo_v1.Color_COLOR_BLUE,
+	"navy":    ufo_v1.Color_COLOR_BLUE,
+	"azure":   ufo_v1.Color_COLOR_BLUE,
+	"purple":  ufo_v1.Color_COLOR_PURPLE,
+	"violet":  ufo_v1.Color_COLOR_PURPLE,
+	"indigo":  ufo_v1.Color_COLOR_PURPLE,
+	"pink":    ufo_v1.Color_COLOR_PINK,
+	"magenta": ufo_v1.Color_COLOR_PINK,
+	"fuchsia": ufo_v1.Color_COLOR_PINK,
+	"brown":   ufo_v1.Color_COLOR_BROWN,
+	"maroon":  ufo_v1.Color_COLOR_BROWN,
+	"white":   ufo_v1.Color_COLOR_WHITE,
+	"gray":    ufo_v1.Color_COLOR_GRAY,
+	"grey":    ufo_v1.Color_COLOR_GRAY,
+	"silver":  ufo_v1.Color_COLOR_GRAY,
+	"black":   ufo_v1.Color_COLOR_BLACK,
+
+	"красн":     ufo_v1.Color_COLOR_RED,
+	"ал":        ufo_v1.Color_COLOR_RED,
+	"оранжев":   ufo_v1.Color_COLOR_ORANGE,
+	"рыж":       ufo_v1.Color_COLOR_ORANGE,
+	"желт":      ufo_v1.Color_COLOR_YELLOW,
+	"золот":     ufo_v1.Color_COLOR_YELLOW,
+	"зелен":     ufo_v1.Color_COLOR_GREEN,
+	"салатов":   ufo_v1.Color_COLOR_GREEN,
+	"голуб":     ufo_v1.Color_COLOR_CYAN,
+	"бирюзов":   ufo_v1.Color_COLOR_CYAN,
+	"син":       ufo_v1.Color_COLOR_BLUE,
+	"фиолетов":  ufo_v1.Color_COLOR_PURPLE,
+	"сиренев":   ufo_v1.Color_COLOR_PURPLE,
+	"пурпурн":   ufo_v1.Color_COLOR_PURPLE,
+	"розов":     ufo_v1.Color_COLOR_PINK,
+	"малинов":   ufo_v1.Color_COLOR_PINK,
+	"коричнев":  ufo_v1.Color_COLOR_BROWN,
+	"бур":       ufo_v1.Color_COLOR_BROWN,
+	"бел":       ufo_v1.Color_COLOR_WHITE,
+	"сер":       ufo_v1.Color_COLOR_GRAY,
+	"серебрист": ufo_v1.Color_COLOR_GRAY,
+	"черн":      ufo_v1.Color_COLOR_BLACK,
+}
+
+// adjectiveEndings окончания русских прилагательных во всех родах и числах.
+var adjectiveEndings = []string{"ый", "ий", "ой", "ая", "яя", "ое", "ее", "ые", "ие"}
+
+// Normalize сводит цвет в свободной форме к палитре: названия на русском и
+// английском (в том числе с уточнениями вроде "ярко-красный" или "dark blue"),
+// #rgb, #rrggbb и rgb(r, g, b). Нераспознанная строка дает COLOR_UNSPECIFIED.
+func Normalize(raw string) ufo_v1.Color {
+	s := strings.ToLower(strings.TrimSpace(raw))
+	if s == "" {
+		return ufo_v1.Color_COLOR_UNSPECIFIED
+	}
+
+	if r, g, b, ok := parseRGB(s); ok {
+		return Nearest(r, g, b)
+	}
+
+	// Уточнения стоят перед основным цветом, поэтому ищем с последнего слова.
+	words := strings.FieldsFunc(strings.ReplaceAll(s, "ё", "е"), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	for i := len(words) - 1; i >= 0; i-- {
+		if c, ok := names[words[i]]; ok {
+			return c
+		}
+		if c, ok := names[stem(words[i])]; ok {
+			return c
+		}
+	}
+	return ufo_v1.Color_COLOR_UNSPECIFIED
+}
+
+func stem(word string) string {
+	for _, ending := range adjectiveEndings {
+		if base, ok := strings.CutSuffix(word, ending); ok && base != "" {
+			return base
+		}
+	}
+	return word
+}
+
+// parseRGB разбирает #rgb, #rrggbb и rgb(r, g, b).
+func parseRGB(s string) (r, g, b uint8, ok bool) {
+	if hex, found := strings.CutPrefix(s, "#"); found {
+		if len(hex) == 3 {
+			hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+		}
+		if len(hex) != 6 {
+			return 0, 0, 0, false
+		}
+		v, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			return 0, 0, 0, false
+		}
+		return uint8(v >> 16), uint8(v >> 8), uint8(v), true
+	}
+
+	args, found := strings.CutPrefix(s, "rgb(")
+	if !found {
+		return 0, 0, 0, false
+	}
+	args, found = strings.CutSuffix(args, ")")
+	if !found {
+		return 0, 0, 0, false
+	}
+	parts := strings.Split(args, ",")
+	if len(parts) != 3 {
+		return 0, 0, 0, false
+	}
+	var rgb [3]uint8
+	for i, p := range parts {
+		v, err := strconv.ParseUint(strings.TrimSpace(p), 10, 8)
+		if err != nil {
+			return 0, 0, 0, false
+		}
+		rgb[i] = uint8(v)
+	}
+	return rgb[0], rgb[1], rgb[2], true
+}
+
+// Nearest возвращает ближайший цвет палитры. Сравнение идет в HSV: темные
+// цвета считаются черными, ненасыщенные — белыми или серыми, остальные
+// распределяются по тону; темный оранжевый дает коричневый.
+func Nearest(r, g, b uint8) ufo_v1.Color {
+	h, s, v := hsv(r, g, b)
+
+	switch {
+	case v < 0.2:
+		return ufo_v1.Color_COLOR_BLACK
+	case s < 0.15 && v > 0.85:
+		return ufo_v1.Color_COLOR_WHITE
+	case s < 0.15:
+		return ufo_v1.Color_COLOR_GRAY
+	}
+
+	switch {
+	case h < 15 || h >= 335:
+		return ufo_v1.Color_COLOR_RED
+	case h < 45:
+		if v < 0.65 {
+			return ufo_v1.Color_COLOR_BROWN
+		}
+		return ufo_v1.Color_COLOR_ORANGE
+	case h < 70:
+		return ufo_v1.Color_COLOR_YELLOW
+	case h < 165:
+		return ufo_v1.Color_COLOR_GREEN
+	case h < 195:
+		return ufo_v1.Color_COLOR_CYAN
+	case h < 255:
+		return ufo_v1.Color_COLOR_BLUE
+	case h < 290:
+		return ufo_v1.Color_COLOR_PURPLE
+	default:
+		return ufo_v1.Color_COLOR_PINK
+	}
+}
+
+// hsv переводит RGB в тон (0..360), насыщенность и яркость (0..1).
+func hsv(r, g, b uint8) (h, s, v float64) {
+	rf, gf, bf := float64(r)/255, float64(g)/255, float64(b)/255
+	maxC := max(rf, gf, bf)
+	minC := min(rf, gf, bf)
+	delta := maxC - minC
+
+	v = maxC
+	if maxC > 0 {
+		s = delta / maxC
+	}
+	if delta == 0 {
+		return 0, s, v
+	}
+
+	switch maxC {
+	case rf:
+		h = 60 * (gf - bf) / delta
+	case gf:
+		h = 60 * ((bf-rf)/delta + 2)
+	default:
+		h = 60 * ((rf-gf)/delta + 4)
+	}
+	if h < 0 {
+		h += 360
+	}
+	return h, s, v
+}
@@ -0,0 +1,131 @@
+package colors
+
+import (
+	"testing"
+
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+)
+
+func TestNormalizeNames(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want ufo_v1.Color
+	}{
+		{raw: "red", want: ufo_v1.Color_COLOR_RED},
+		{raw: "  Dark Blue ", want: ufo_v1.Color_COLOR_BLUE},
+		{raw: "light-green", want: ufo_v1.Color_COLOR_GREEN},
+		{raw: "GREY", want: ufo_v1.Color_COLOR_GRAY},
+		{raw: "silver", want: ufo_v1.Color_COLOR_GRAY},
+		{raw: "maroon", want: ufo_v1.Color_COLOR_BROWN},
+
+		{raw: "красный", want: ufo_v1.Color_COLOR_RED},
+		{raw: "ярко-красная", want: ufo_v1.Color_COLOR_RED},
+		{raw: "алый", want: ufo_v1.Color_COLOR_RED},
+		{raw: "алая", want: ufo_v1.Color_COLOR_RED},
+		{raw: "алое", want: ufo_v1.Color_COLOR_RED},
+		{raw: "бурый", want: ufo_v1.Color_COLOR_BROWN},
+		{raw: "бурая", want: ufo_v1.Color_COLOR_BROWN},
+		{raw: "бурые", want: ufo_v1.Color_COLOR_BROWN},
+		{raw: "Желтый", want: ufo_v1.Color_COLOR_YELLOW},
+		{raw: "жёлтое", want: ufo_v1.Color_COLOR_YELLOW},
+		{raw: "синяя", want: ufo_v1.Color_COLOR_BLUE},
+		{raw: "темно-серые", want: ufo_v1.Color_COLOR_GRAY},
+		{raw: "черный", want: ufo_v1.Color_COLOR_BLACK},
+
+		// Основной цвет — последнее слово.
+		{raw: "зеленовато-голубой", want: ufo_v1.Color_COLOR_CYAN},
+		{raw: "red and blue", want: ufo_v1.Color_COLOR_BLUE},
+
+		{raw: "", want: ufo_v1.Color_COLOR_UNSPECIFIED},
+		{raw: "   ", want: ufo_v1.Color_COLOR_UNSPECIFIED},
+		{raw: "переливающийся", want: ufo_v1.Color_COLOR_UNSPECIFIED},
+		{raw: "sparkly", want: ufo_v1.Color_COLOR_UNSPECIFIED},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			if got := Normalize(tt.raw); got != tt.want {
+				t.Errorf("Normalize(%q) = %v, want %v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeRGB(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want ufo_v1.Color
+	}{
+		{raw: "#f00", want: ufo_v1.Color_COLOR_RED},
+		{raw: "#0F0", want: ufo_v1.Color_COLOR_GREEN},
+		{raw: "#0000ff", want: ufo_v1.Color_COLOR_BLUE},
+		{raw: "#FFFFFF", want: ufo_v1.Color_COLOR_WHITE},
+		{raw: "#808080", want: ufo_v1.Color_COLOR_GRAY},
+		{raw: "#8b4513", want: ufo_v1.Color_COLOR_BROWN},
+		{raw: "rgb(255, 165, 0)", want: ufo_v1.Color_COLOR_ORANGE},
+		{raw: "RGB(0,255,255)", want: ufo_v1.Color_COLOR_CYAN},
+		{raw: "rgb( 255 , 0 , 200 )", want: ufo_v1.Color_COLOR_PINK},
+
+		{raw: "#ff", want: ufo_v1.Color_COLOR_UNSPECIFIED},
+		{raw: "#ff00001", want: ufo_v1.Color_COLOR_UNSPECIFIED},
+		{raw: "#ggg", want: ufo_v1.Color_COLOR_UNSPECIFIED},
+		{raw: "rgb(1, 2)", want: ufo_v1.Color_COLOR_UNSPECIFIED},
+		{raw: "rgb(256, 0, 0)", want: ufo_v1.Color_COLOR_UNSPECIFIED},
+		{raw: "rgb(-1, 0, 0)", want: ufo_v1.Color_COLOR_UNSPECIFIED},
+		{raw: "rgb(1, 2, 3", want: ufo_v1.Color_COLOR_UNSPECIFIED},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			if got := Normalize(tt.raw); got != tt.want {
+				t.Errorf("Normalize(%q) = %v, want %v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestNearestBoundaries проверяет цвета по обе стороны каждой границы HSV.
+func TestNearestBoundaries(t *testing.T) {
+	tests := []struct {
+		name    string
+		r, g, b uint8
+		want    ufo_v1.Color
+	}{
+		{name: "v just below 0.2", r: 50, want: ufo_v1.Color_COLOR_BLACK},
+		{name: "v just above 0.2", r: 52, want: ufo_v1.Color_COLOR_RED},
+		{name: "black", want: ufo_v1.Color_COLOR_BLACK},
+
+		{name: "unsaturated bright", r: 230, g: 230, b: 230, want: ufo_v1.Color_COLOR_WHITE},
+		{name: "unsaturated v at 0.85", r: 216, g: 216, b: 216, want: ufo_v1.Color_COLOR_GRAY},
+		{name: "s just below 0.15", r: 200, g: 171, b: 171, want: ufo_v1.Color_COLOR_GRAY},
+		{name: "s just above 0.15", r: 200, g: 169, b: 169, want: ufo_v1.Color_COLOR_RED},
+
+		{name: "hue below 15", r: 255, g: 63, want: ufo_v1.Color_COLOR_RED},
+		{name: "hue above 15", r: 255, g: 64, want: ufo_v1.Color_COLOR_ORANGE},
+		{name: "dark orange", r: 153, g: 76, want: ufo_v1.Color_COLOR_BROWN},
+		{name: "orange at v 0.65", r: 166, g: 83, want: ufo_v1.Color_COLOR_ORANGE},
+		{name: "hue below 45", r: 255, g: 191, want: ufo_v1.Color_COLOR_ORANGE},
+		{name: "hue above 45", r: 255, g: 192, want: ufo_v1.Color_COLOR_YELLOW},
+		{name: "hue below 70", r: 213, g: 255, want: ufo_v1.Color_COLOR_YELLOW},
+		{name: "hue above 70", r: 212, g: 255, want: ufo_v1.Color_COLOR_GREEN},
+		{name: "hue below 165", g: 255, b: 191, want: ufo_v1.Color_COLOR_GREEN},
+		{name: "hue above 165", g: 255, b: 192, want: ufo_v1.Color_COLOR_CYAN},
+		{name: "hue below 195", g: 192, b: 255, want: ufo_v1.Color_COLOR_CYAN},
+		{name: "hue above 195", g: 191, b: 255, want: ufo_v1.Color_COLOR_BLUE},
+		{name: "hue below 255", r: 63, b: 255, want: ufo_v1.Color_COLOR_BLUE},
+		{name: "hue above 255", r: 64, b: 255, want: ufo_v1.Color_COLOR_PURPLE},
+		{name: "hue below 290", r: 212, b: 255, want: ufo_v1.Color_COLOR_PURPLE},
+		{name: "hue above 290", r: 213, b: 255, want: ufo_v1.Color_COLOR_PINK},
+		{name: "hue below 335", r: 255, b: 107, want: ufo_v1.Color_COLOR_PINK},
+		{name: "hue above 335", r: 255, b: 106, want: ufo_v1.Color_COLOR_RED},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Nearest(tt.r, tt.g, tt.b); got != tt.want {
+				h, s, v := hsv(tt.r, tt.g, tt.b)
+				t.Errorf("Nearest(%d, %d, %d) = %v, want %v (h %.2f, s %.3f, v %.3f)", tt.r, tt.g, tt.b, got, tt.want, h, s, v)
+			}
+		})
+	}
+}
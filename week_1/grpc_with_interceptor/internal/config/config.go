@@ -0,0 +1,549 @@
+// Package config конфигурация UFO сервера (cmd/grpc_server). Загрузку из
+// YAML, переменных окружения UFO_* и флагов и общие секции дает
+// pkg/serverconfig, который используют и серверы остальных модулей week_1.
+// Клиенты этого модуля (grpc_client, ufoctl, ufo_loadgen) настраиваются
+// своими флагами.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/filter"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/interceptor"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/persistence"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/retention"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/tlsconf"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/webhook"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/serverconfig"
+)
+
+// Config конфигурация UFO сервера: gRPC, gateway, хранилище, логи и интерцепторы.
+type Config struct {
+	Mode         string            `yaml:"mode" usage:"режим портов: split (gRPC, gateway и admin на своих портах) или single (gRPC и gateway на grpc.port, admin на admin.port)"`
+	GRPC         GRPCConfig        `yaml:"grpc"`
+	Gateway      GatewayConfig     `yaml:"gateway"`
+	Admin        AdminConfig       `yaml:"admin"`
+	TLS          TLSConfig         `yaml:"tls"`
+	Storage      StorageConfig     `yaml:"storage"`
+	Log          LogConfig         `yaml:"log"`
+	Interceptors InterceptorConfig `yaml:"interceptors"`
+	Health       HealthConfig      `yaml:"health"`
+	Webhooks     WebhooksConfig    `yaml:"webhooks"`
+	Retention    RetentionConfig   `yaml:"retention"`
+	Filter       FilterConfig      `yaml:"filter"`
+	API          APIConfig         `yaml:"api"`
+}
+
+// Режимы размещения серверов по портам.
+const (
+	// ModeSplit gRPC, gateway и admin слушают каждый свой порт.
+	ModeSplit = "split"
+	// ModeSingle gRPC и gateway обслуживаются на одном порту grpc.port,
+	// запросы разделяются по content-type application/grpc. Admin и в этом
+	// режиме слушает свой admin.port, чтобы /debug/ не попал на публичный порт.
+	ModeSingle = "single"
+)
+
+// GRPCConfig настройки gRPC сервера.
+type GRPCConfig = serverconfig.GRPCConfig
+
+// Способы подключения gateway к gRPC серверу.
+const (
+	// UpstreamInProcess gateway вызывает gRPC сервер через in-memory соединение.
+	UpstreamInProcess = "inprocess"
+	// UpstreamTCP gateway подключается к gRPC серверу по сети через grpc.port.
+	UpstreamTCP = "tcp"
+)
+
+// GatewayConfig настройки HTTP сервера с grpc-gateway.
+type GatewayConfig struct {
+	Enabled           bool          `yaml:"enabled" usage:"запускать HTTP gateway"`
+	Upstream          string        `yaml:"upstream" usage:"подключение gateway к gRPC: inprocess (in-memory) или tcp (loopback)"`
+	Host              string        `yaml:"host" usage:"адрес, на котором слушает HTTP gateway"`
+	Port              int           `yaml:"port" usage:"порт HTTP gateway"`
+	ReadTimeout       time.Duration `yaml:"read_timeout" usage:"таймаут чтения запроса"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout" usage:"таймаут чтения заголовков запроса"`
+	WriteTimeout      time.Duration `yaml:"write_timeout" usage:"таймаут записи ответа"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout" usage:"таймаут простоя keep-alive соединения"`
+	ShutdownTimeout   time.Duration `yaml:"shutdown_timeout" usage:"таймаут graceful shutdown HTTP gateway"`
+	// ForwardHeaders HTTP заголовки, которые gateway передает в gRPC метаданные
+	// под тем же именем в нижнем регистре (помимо стандартных Grpc-Metadata-*).
+	ForwardHeaders []string         `yaml:"forward_headers" usage:"HTTP заголовки, передаваемые в gRPC метаданные"`
+	Middleware     MiddlewareConfig `yaml:"middleware"`
+	JSON           JSONConfig       `yaml:"json"`
+	// WebRPC обслуживает UFOService по протоколам gRPC-Web и Connect на том же
+	// HTTP порту (пути /ufo.v1.UFOService/<метод>) для браузерных клиентов.
+	WebRPC bool `yaml:"web_rpc" usage:"обслуживать gRPC-Web и Connect на HTTP порту gateway"`
+}
+
+// JSONConfig настройки JSON представления ответов gateway.
+type JSONConfig struct {
+	EmitUnpopulated bool `yaml:"emit_unpopulated" usage:"выводить поля с нулевыми значениями"`
+	UseProtoNames   bool `yaml:"use_proto_names" usage:"имена полей как в .proto (snake_case) вместо camelCase"`
+	UseEnumNumbers  bool `yaml:"use_enum_numbers" usage:"выводить enum числами вместо имен"`
+}
+
+// MiddlewareConfig настройки HTTP middleware вокруг gateway.
+type MiddlewareConfig struct {
+	AccessLog    bool       `yaml:"access_log" usage:"логировать HTTP запросы с кодом и размером ответа"`
+	Recovery     bool       `yaml:"recovery" usage:"перехватывать панику в обработчиках и отвечать 500"`
+	Compression  bool       `yaml:"compression" usage:"сжимать ответы gzip"`
+	MaxBodyBytes int64      `yaml:"max_body_bytes" usage:"максимальный размер тела запроса в байтах (0 — без ограничения)"`
+	CORS         CORSConfig `yaml:"cors"`
+}
+
+// CORSConfig политика CORS для браузерных дашбордов.
+type CORSConfig struct {
+	AllowedOrigins   []string      `yaml:"allowed_origins" usage:"разрешенные источники CORS (* — любые, пусто — CORS выключен)"`
+	AllowedMethods   []string      `yaml:"allowed_methods" usage:"разрешенные методы CORS"`
+	AllowedHeaders   []string      `yaml:"allowed_headers" usage:"разрешенные заголовки запроса CORS"`
+	ExposedHeaders   []string      `yaml:"exposed_headers" usage:"заголовки ответа, доступные браузеру"`
+	AllowCredentials bool          `yaml:"allow_credentials" usage:"разрешить cookies и авторизацию в CORS запросах"`
+	MaxAge           time.Duration `yaml:"max_age" usage:"время кеширования preflight ответа"`
+}
+
+// Address возвращает адрес для http.Server.
+func (c GatewayConfig) Address() string {
+	return net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
+}
+
+// AdminConfig настройки служебного HTTP сервера (pprof, expvar, конфигурация).
+type AdminConfig struct {
+	Enabled         bool          `yaml:"enabled" usage:"запускать служебный HTTP сервер"`
+	Host            string        `yaml:"host" usage:"адрес служебного сервера"`
+	Port            int           `yaml:"port" usage:"порт служебного сервера"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" usage:"таймаут graceful shutdown служебного сервера"`
+}
+
+// Address возвращает адрес для http.Server.
+func (c AdminConfig) Address() string {
+	return net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
+}
+
+// TLSConfig настройки TLS для gRPC и gateway (см. tlsconf.Config).
+type TLSConfig struct {
+	CertFile       string        `yaml:"cert_file" usage:"путь к сертификату сервера (включает TLS)"`
+	KeyFile        string        `yaml:"key_file" secret:"true" usage:"путь к ключу сертификата сервера"`
+	CAFile         string        `yaml:"ca_file" usage:"путь к CA для проверки клиентов и gateway→gRPC соединения"`
+	ClientAuth     bool          `yaml:"client_auth" usage:"требовать клиентский сертификат (mTLS) на gRPC"`
+	ServerName     string        `yaml:"server_name" usage:"имя в сертификате сервера, которое проверяет gateway"`
+	ReloadInterval time.Duration `yaml:"reload_interval" usage:"интервал проверки сертификатов на диске"`
+}
+
+// Tlsconf преобразует настройки в tlsconf.Config.
+func (c TLSConfig) Tlsconf() tlsconf.Config {
+	return tlsconf.Config{
+		CertFile:       c.CertFile,
+		KeyFile:        c.KeyFile,
+		CAFile:         c.CAFile,
+		ClientAuth:     c.ClientAuth,
+		ServerName:     c.ServerName,
+		ReloadInterval: c.ReloadInterval,
+	}
+}
+
+// Поддерживаемые бэкенды хранилища.
+const (
+	StorageMemory = serverconfig.StorageMemory
+)
+
+// StorageConfig настройки хранилища наблюдений.
+type StorageConfig struct {
+	Backend string `yaml:"backend" usage:"бэкенд хранилища наблюдений (memory)"`
+	// Shards количество сегментов in-memory хранилища с отдельными блокировками.
+	Shards int `yaml:"shards" usage:"количество сегментов in-memory хранилища (1 — общий мьютекс)"`
+	// Dir включает журнал упреждающей записи и снимки в этом каталоге.
+	Dir              string        `yaml:"dir" usage:"каталог WAL и снимков (пусто — данные только в памяти)"`
+	Fsync            string        `yaml:"fsync" usage:"политика fsync WAL: always, interval или never"`
+	FsyncInterval    time.Duration `yaml:"fsync_interval" usage:"интервал fsync WAL для политики interval"`
+	SnapshotInterval time.Duration `yaml:"snapshot_interval" usage:"интервал снимков с компактизацией WAL (0 — только при остановке)"`
+	ShutdownTimeout  time.Duration `yaml:"shutdown_timeout" usage:"таймаут записи снимка при остановке"`
+}
+
+// Persistence преобразует настройки в persistence.Options.
+func (c StorageConfig) Persistence() persistence.Options {
+	return persistence.Options{
+		Dir:              c.Dir,
+		Fsync:            c.Fsync,
+		FsyncInterval:    c.FsyncInterval,
+		SnapshotInterval: c.SnapshotInterval,
+	}
+}
+
+// LogConfig настройки логирования.
+type LogConfig = serverconfig.LogConfig
+
+// InterceptorConfig включает и выключает серверные интерцепторы.
+type InterceptorConfig = serverconfig.InterceptorConfig
+
+// HealthConfig настройки проверки здоровья.
+type HealthConfig struct {
+	CheckInterval time.Duration `yaml:"check_interval" usage:"интервал проверки готовности хранилища"`
+	// ShutdownDelay пауза между переводом readiness в NOT_SERVING и остановкой
+	// серверов: за это время балансировщик успевает заметить смену статуса и
+	// перестает присылать новые запросы.
+	ShutdownDelay time.Duration `yaml:"shutdown_delay" usage:"пауза после снятия готовности перед остановкой серверов"`
+}
+
+// WebhooksConfig настройки доставки webhook уведомлений о наблюдениях.
+type WebhooksConfig struct {
+	Enabled         bool          `yaml:"enabled" usage:"включить webhook подписки и фоновую доставку событий"`
+	Workers         int           `yaml:"workers" usage:"количество параллельных отправителей webhook"`
+	QueueSize       int           `yaml:"queue_size" usage:"емкость очереди событий, переполнение уходит в dead letter"`
+	MaxAttempts     int           `yaml:"max_attempts" usage:"максимум попыток доставки одного события"`
+	InitialBackoff  time.Duration `yaml:"initial_backoff" usage:"пауза перед первым повтором, далее удваивается"`
+	MaxBackoff      time.Duration `yaml:"max_backoff" usage:"максимальная пауза между повторами"`
+	Timeout         time.Duration `yaml:"timeout" usage:"таймаут одного HTTP запроса к получателю"`
+	HistorySize     int           `yaml:"history_size" usage:"сколько последних доставок и dead letter хранить"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" usage:"время на доставку очереди при остановке"`
+	// AllowedNetworks CIDR сетей, в которые разрешена доставка, хотя адреса в
+	// них внутренние (loopback, link-local, частные, CGNAT). По умолчанию пусто.
+	AllowedNetworks []string `yaml:"allowed_networks" usage:"CIDR внутренних сетей, куда разрешено отправлять webhook"`
+}
+
+// Webhook преобразует настройки в webhook.Options. Сети проверены в Validate.
+func (c WebhooksConfig) Webhook() webhook.Options {
+	allowed := make([]netip.Prefix, 0, len(c.AllowedNetworks))
+	for _, cidr := range c.AllowedNetworks {
+		if prefix, err := netip.ParsePrefix(cidr); err == nil {
+			allowed = append(allowed, prefix)
+		}
+	}
+
+	return webhook.Options{
+		Workers:         c.Workers,
+		QueueSize:       c.QueueSize,
+		MaxAttempts:     c.MaxAttempts,
+		InitialBackoff:  c.InitialBackoff,
+		MaxBackoff:      c.MaxBackoff,
+		Timeout:         c.Timeout,
+		HistorySize:     c.HistorySize,
+		AllowedNetworks: allowed,
+	}
+}
+
+// RetentionConfig настройки очистки мягко удаленных наблюдений.
+type RetentionConfig struct {
+	Enabled         bool          `yaml:"enabled" usage:"окончательно удалять наблюдения после срока хранения"`
+	MaxAge          time.Duration `yaml:"max_age" usage:"срок хранения наблюдения после мягкого удаления"`
+	Interval        time.Duration `yaml:"interval" usage:"период запуска очистки"`
+	DryRun          bool          `yaml:"dry_run" usage:"только логировать кандидатов на удаление"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" usage:"время на завершение прохода очистки при остановке"`
+}
+
+// Retention преобразует настройки в retention.Options.
+func (c RetentionConfig) Retention() retention.Options {
+	return retention.Options{
+		Retention: c.MaxAge,
+		Interval:  c.Interval,
+		DryRun:    c.DryRun,
+	}
+}
+
+// FilterConfig настройки CEL фильтров GetAll.
+type FilterConfig struct {
+	CostLimit int64 `yaml:"cost_limit" usage:"лимит стоимости вычисления фильтра для одного наблюдения"`
+	CacheSize int   `yaml:"cache_size" usage:"сколько скомпилированных фильтров держать в кэше"`
+}
+
+// Filter преобразует настройки в filter.Options.
+func (c FilterConfig) Filter() filter.Options {
+	return filter.Options{
+		CostLimit: uint64(c.CostLimit),
+		CacheSize: c.CacheSize,
+	}
+}
+
+// APIConfig настройки версий API.
+type APIConfig struct {
+	V2            bool              `yaml:"v2" usage:"обслуживать ufo.v2.UFOService и REST /api/v2/ufo"`
+	V1Deprecation DeprecationConfig `yaml:"v1_deprecation"`
+}
+
+// dateLayout формат дат в настройках устаревания API.
+const dateLayout = time.DateOnly
+
+// DeprecationConfig заголовки устаревания методов наблюдений ufo.v1.
+type DeprecationConfig struct {
+	Enabled bool   `yaml:"enabled" usage:"добавлять к ответам методов наблюдений ufo.v1 заголовки Deprecation, Sunset и Link"`
+	Since   string `yaml:"since" usage:"дата (YYYY-MM-DD), с которой API считается устаревшим"`
+	Sunset  string `yaml:"sunset" usage:"дата (YYYY-MM-DD) отключения API для заголовка Sunset, пусто — не отправлять"`
+}
+
+// Deprecation преобразует настройки в interceptor.Deprecation для методов
+// methods с преемником successor. Даты должны быть проверены Validate.
+func (c DeprecationConfig) Deprecation(methods []string, successor string) interceptor.Deprecation {
+	d := interceptor.Deprecation{
+		Methods:   methods,
+		Successor: successor,
+	}
+	d.Since, _ = time.Parse(dateLayout, c.Since)
+	if c.Sunset != "" {
+		d.Sunset, _ = time.Parse(dateLayout, c.Sunset)
+	}
+	return d
+}
+
+// Default возвращает конфигурацию по умолчанию.
+func Default() Config {
+	return Config{
+		Mode: ModeSplit,
+		GRPC: GRPCConfig{
+			Port:            50051,
+			ShutdownTimeout: 10 * time.Second,
+		},
+		Gateway: GatewayConfig{
+			Enabled:           true,
+			Upstream:          UpstreamInProcess,
+			Port:              8081,
+			ReadTimeout:       10 * time.Second,
+			ReadHeaderTimeout: 5 * time.Second,
+			WriteTimeout:      30 * time.Second,
+			IdleTimeout:       60 * time.Second,
+			ShutdownTimeout:   10 * time.Second,
+			ForwardHeaders:    []string{"X-Request-Id", "User-Agent", "X-Forwarded-For"},
+			WebRPC:            true,
+			JSON: JSONConfig{
+				EmitUnpopulated: true,
+				UseProtoNames:   true,
+			},
+			Middleware: MiddlewareConfig{
+				AccessLog:    true,
+				Recovery:     true,
+				Compression:  true,
+				MaxBodyBytes: 1 << 20,
+				CORS: CORSConfig{
+					AllowedMethods: []string{"GET", "POST", "PATCH", "DELETE", "OPTIONS"},
+					AllowedHeaders: []string{"Content-Type", "Authorization", "X-Request-Id"},
+					ExposedHeaders: []string{"X-Request-Id"},
+					MaxAge:         10 * time.Minute,
+				},
+			},
+		},
+		Admin: AdminConfig{
+			Enabled:         true,
+			Host:            "localhost",
+			Port:            8090,
+			ShutdownTimeout: 5 * time.Second,
+		},
+		TLS: TLSConfig{
+			ServerName:     "localhost",
+			ReloadInterval: tlsconf.DefaultReloadInterval,
+		},
+		Storage: StorageConfig{
+			Backend:          StorageMemory,
+			Shards:           32,
+			Fsync:            persistence.FsyncInterval,
+			FsyncInterval:    time.Second,
+			SnapshotInterval: 10 * time.Minute,
+			ShutdownTimeout:  30 * time.Second,
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: "text",
+		},
+		Interceptors: InterceptorConfig{
+			Logging:    true,
+			Validation: true,
+		},
+		Health: HealthConfig{
+			CheckInterval: 5 * time.Second,
+			ShutdownDelay: 5 * time.Second,
+		},
+		Webhooks: WebhooksConfig{
+			Enabled:         true,
+			Workers:         4,
+			QueueSize:       1024,
+			MaxAttempts:     5,
+			InitialBackoff:  500 * time.Millisecond,
+			MaxBackoff:      30 * time.Second,
+			Timeout:         5 * time.Second,
+			HistorySize:     1000,
+			ShutdownTimeout: 10 * time.Second,
+		},
+		Retention: RetentionConfig{
+			Enabled:         true,
+			MaxAge:          30 * 24 * time.Hour,
+			Interval:        time.Hour,
+			ShutdownTimeout: 5 * time.Second,
+		},
+		Filter: FilterConfig{
+			CostLimit: filter.DefaultCostLimit,
+			CacheSize: filter.DefaultCacheSize,
+		},
+		API: APIConfig{
+			V2: true,
+			V1Deprecation: DeprecationConfig{
+				Enabled: true,
+				Since:   "2026-10-18",
+			},
+		},
+	}
+}
+
+// Validate проверяет конфигурацию и возвращает все найденные ошибки.
+func (c Config) Validate() error {
+	var errs []error
+
+	checkPort := func(name string, port int) {
+		if port < 1 || port > 65535 {
+			errs = append(errs, fmt.Errorf("%s: must be between 1 and 65535, got %d", name, port))
+		}
+	}
+	checkPositive := func(name string, d time.Duration) {
+		if d <= 0 {
+			errs = append(errs, fmt.Errorf("%s: must be positive, got %v", name, d))
+		}
+	}
+
+	switch c.Mode {
+	case ModeSplit, ModeSingle:
+	default:
+		errs = append(errs, fmt.Errorf("mode: unsupported mode %q", c.Mode))
+	}
+	split := c.Mode != ModeSingle
+
+	if err := c.GRPC.Validate("grpc"); err != nil {
+		errs = append(errs, err)
+	}
+
+	if c.Gateway.Enabled {
+		switch c.Gateway.Upstream {
+		case UpstreamInProcess, UpstreamTCP:
+		default:
+			errs = append(errs, fmt.Errorf("gateway.upstream: unsupported upstream %q", c.Gateway.Upstream))
+		}
+		if split {
+			checkPort("gateway.port", c.Gateway.Port)
+			if c.Gateway.Port == c.GRPC.Port && c.Gateway.Host == c.GRPC.Host {
+				errs = append(errs, errors.New("gateway.port: must differ from grpc.port"))
+			}
+		}
+		checkPositive("gateway.read_timeout", c.Gateway.ReadTimeout)
+		checkPositive("gateway.read_header_timeout", c.Gateway.ReadHeaderTimeout)
+		checkPositive("gateway.write_timeout", c.Gateway.WriteTimeout)
+		checkPositive("gateway.idle_timeout", c.Gateway.IdleTimeout)
+		checkPositive("gateway.shutdown_timeout", c.Gateway.ShutdownTimeout)
+		if c.Gateway.Middleware.MaxBodyBytes < 0 {
+			errs = append(errs, fmt.Errorf("gateway.middleware.max_body_bytes: must not be negative, got %d", c.Gateway.Middleware.MaxBodyBytes))
+		}
+		cors := c.Gateway.Middleware.CORS
+		if cors.AllowCredentials && slices.Contains(cors.AllowedOrigins, "*") {
+			errs = append(errs, errors.New("gateway.middleware.cors: allow_credentials cannot be used with origin *"))
+		}
+	}
+
+	if c.Admin.Enabled {
+		checkPort("admin.port", c.Admin.Port)
+		if c.Admin.Port == c.GRPC.Port || (split && c.Gateway.Enabled && c.Admin.Port == c.Gateway.Port) {
+			errs = append(errs, errors.New("admin.port: must differ from grpc.port and gateway.port"))
+		}
+		checkPositive("admin.shutdown_timeout", c.Admin.ShutdownTimeout)
+	}
+
+	if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		errs = append(errs, errors.New("tls: cert_file and key_file must be set together"))
+	}
+	if c.TLS.ClientAuth && c.TLS.CAFile == "" {
+		errs = append(errs, errors.New("tls.client_auth: requires tls.ca_file"))
+	}
+	// TLS на сервере включает сертификат; ca_file без него ничего не защищает.
+	if c.TLS.CertFile == "" && (c.TLS.CAFile != "" || c.TLS.ClientAuth) {
+		errs = append(errs, errors.New("tls.ca_file: requires tls.cert_file"))
+	}
+	if c.TLS.CertFile != "" {
+		checkPositive("tls.reload_interval", c.TLS.ReloadInterval)
+		if c.TLS.ServerName == "" {
+			errs = append(errs, errors.New("tls.server_name: must not be empty"))
+		}
+	}
+
+	switch c.Storage.Backend {
+	case StorageMemory:
+	default:
+		errs = append(errs, fmt.Errorf("storage.backend: unsupported backend %q", c.Storage.Backend))
+	}
+	if c.Storage.Shards < 1 {
+		errs = append(errs, fmt.Errorf("storage.shards: must be positive, got %d", c.Storage.Shards))
+	}
+	if c.Storage.Dir != "" {
+		switch c.Storage.Fsync {
+		case persistence.FsyncAlways, persistence.FsyncNever:
+		case persistence.FsyncInterval:
+			checkPositive("storage.fsync_interval", c.Storage.FsyncInterval)
+		default:
+			errs = append(errs, fmt.Errorf("storage.fsync: unsupported policy %q", c.Storage.Fsync))
+		}
+		checkPositive("storage.shutdown_timeout", c.Storage.ShutdownTimeout)
+		if c.Storage.SnapshotInterval < 0 {
+			errs = append(errs, fmt.Errorf("storage.snapshot_interval: must not be negative, got %v", c.Storage.SnapshotInterval))
+		}
+	}
+
+	if err := c.Log.Validate("log"); err != nil {
+		errs = append(errs, err)
+	}
+
+	checkPositive("health.check_interval", c.Health.CheckInterval)
+	if c.Health.ShutdownDelay < 0 {
+		errs = append(errs, fmt.Errorf("health.shutdown_delay: must not be negative, got %v", c.Health.ShutdownDelay))
+	}
+
+	if c.Webhooks.Enabled {
+		checkCount := func(name string, v int) {
+			if v < 1 {
+				errs = append(errs, fmt.Errorf("%s: must be positive, got %d", name, v))
+			}
+		}
+		checkCount("webhooks.workers", c.Webhooks.Workers)
+		checkCount("webhooks.queue_size", c.Webhooks.QueueSize)
+		checkCount("webhooks.max_attempts", c.Webhooks.MaxAttempts)
+		checkCount("webhooks.history_size", c.Webhooks.HistorySize)
+		checkPositive("webhooks.initial_backoff", c.Webhooks.InitialBackoff)
+		checkPositive("webhooks.max_backoff", c.Webhooks.MaxBackoff)
+		checkPositive("webhooks.timeout", c.Webhooks.Timeout)
+		checkPositive("webhooks.shutdown_timeout", c.Webhooks.ShutdownTimeout)
+		for _, cidr := range c.Webhooks.AllowedNetworks {
+			if _, err := netip.ParsePrefix(cidr); err != nil {
+				errs = append(errs, fmt.Errorf("webhooks.allowed_networks: %w", err))
+			}
+		}
+	}
+
+	if c.Retention.Enabled {
+		checkPositive("retention.max_age", c.Retention.MaxAge)
+		checkPositive("retention.interval", c.Retention.Interval)
+		checkPositive("retention.shutdown_timeout", c.Retention.ShutdownTimeout)
+	}
+
+	if c.Filter.CostLimit < 1 {
+		errs = append(errs, fmt.Errorf("filter.cost_limit: must be positive, got %d", c.Filter.CostLimit))
+	}
+	if c.Filter.CacheSize < 1 {
+		errs = append(errs, fmt.Errorf("filter.cache_size: must be positive, got %d", c.Filter.CacheSize))
+	}
+
+	if dep := c.API.V1Deprecation; dep.Enabled {
+		since, err := time.Parse(dateLayout, dep.Since)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("api.v1_deprecation.since: want YYYY-MM-DD, got %q", dep.Since))
+		}
+		if dep.Sunset != "" {
+			sunset, sErr := time.Parse(dateLayout, dep.Sunset)
+			switch {
+			case sErr != nil:
+				errs = append(errs, fmt.Errorf("api.v1_deprecation.sunset: want YYYY-MM-DD, got %q", dep.Sunset))
+			case err == nil && !sunset.After(since):
+				errs = append(errs, errors.New("api.v1_deprecation.sunset: must be after since"))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
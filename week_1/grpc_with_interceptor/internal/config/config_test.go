@@ -0,0 +1,159 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDefaultIsValid(t *testing.T) {
+	if err := Default().Validate(); err != nil {
+		t.Fatalf("Default().Validate() = %v", err)
+	}
+}
+
+func TestLoadExampleConfig(t *testing.T) {
+	// Пример из configs/ должен загружаться без ошибок и совпадать с Default.
+	cfg, err := Load("test", []string{"-config", "../../configs/config.yaml"})
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if got, want := cfg.String(), Default().String(); got != want {
+		t.Errorf("configs/config.yaml differs from Default():\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	t.Setenv("UFO_CONFIG", "../../configs/config.yaml")
+	t.Setenv("UFO_GRPC_PORT", "6000")
+	t.Setenv("UFO_GATEWAY_PORT", "6001")
+
+	cfg, err := Load("test", []string{"-grpc.port", "7000"})
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.GRPC.Port != 7000 {
+		t.Errorf("grpc.port = %d, want 7000 from flag over env", cfg.GRPC.Port)
+	}
+	if cfg.Gateway.Port != 6001 {
+		t.Errorf("gateway.port = %d, want 6001 from env over yaml", cfg.Gateway.Port)
+	}
+	if cfg.Admin.Port != 8090 {
+		t.Errorf("admin.port = %d, want 8090 from yaml", cfg.Admin.Port)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*Config)
+		wantErr string
+	}{
+		{name: "unknown mode", modify: func(c *Config) { c.Mode = "dual" }, wantErr: `mode: unsupported mode "dual"`},
+		{name: "grpc port", modify: func(c *Config) { c.GRPC.Port = 0 }, wantErr: "grpc.port: must be between 1 and 65535"},
+		{name: "gateway upstream", modify: func(c *Config) { c.Gateway.Upstream = "udp" }, wantErr: `gateway.upstream: unsupported upstream "udp"`},
+		{name: "gateway port clash", modify: func(c *Config) { c.Gateway.Port = c.GRPC.Port }, wantErr: "gateway.port: must differ from grpc.port"},
+		{
+			name: "single mode shares the port",
+			modify: func(c *Config) {
+				c.Mode = ModeSingle
+				c.Gateway.Port = c.GRPC.Port
+			},
+		},
+		{name: "admin port clash", modify: func(c *Config) { c.Admin.Port = c.Gateway.Port }, wantErr: "admin.port: must differ"},
+		{name: "gateway timeout", modify: func(c *Config) { c.Gateway.WriteTimeout = 0 }, wantErr: "gateway.write_timeout: must be positive"},
+		{name: "negative body limit", modify: func(c *Config) { c.Gateway.Middleware.MaxBodyBytes = -1 }, wantErr: "max_body_bytes: must not be negative"},
+		{
+			name: "cors credentials with any origin",
+			modify: func(c *Config) {
+				c.Gateway.Middleware.CORS.AllowedOrigins = []string{"*"}
+				c.Gateway.Middleware.CORS.AllowCredentials = true
+			},
+			wantErr: "allow_credentials cannot be used with origin *",
+		},
+		{name: "tls key without cert", modify: func(c *Config) { c.TLS.KeyFile = "server.key" }, wantErr: "cert_file and key_file must be set together"},
+		{name: "mtls without ca", modify: func(c *Config) { c.TLS.ClientAuth = true }, wantErr: "tls.client_auth: requires tls.ca_file"},
+		{name: "storage backend", modify: func(c *Config) { c.Storage.Backend = "redis" }, wantErr: `storage.backend: unsupported backend "redis"`},
+		{
+			name: "fsync policy",
+			modify: func(c *Config) {
+				c.Storage.Dir = "/var/lib/ufo"
+				c.Storage.Fsync = "sometimes"
+			},
+			wantErr: `storage.fsync: unsupported policy "sometimes"`,
+		},
+		{name: "log level", modify: func(c *Config) { c.Log.Level = "verbose" }, wantErr: `log.level: unsupported level "verbose"`},
+		{name: "health interval", modify: func(c *Config) { c.Health.CheckInterval = 0 }, wantErr: "health.check_interval: must be positive"},
+		{name: "health shutdown delay", modify: func(c *Config) { c.Health.ShutdownDelay = -time.Second }, wantErr: "health.shutdown_delay: must not be negative"},
+		{name: "no shutdown delay", modify: func(c *Config) { c.Health.ShutdownDelay = 0 }},
+		{name: "webhook cidr", modify: func(c *Config) { c.Webhooks.AllowedNetworks = []string{"10.0.0.0/33"} }, wantErr: "webhooks.allowed_networks"},
+		{name: "webhooks disabled skip checks", modify: func(c *Config) {
+			c.Webhooks.Enabled = false
+			c.Webhooks.Workers = 0
+		}},
+		{name: "retention interval", modify: func(c *Config) { c.Retention.Interval = -time.Second }, wantErr: "retention.interval: must be positive"},
+		{name: "filter cost", modify: func(c *Config) { c.Filter.CostLimit = 0 }, wantErr: "filter.cost_limit: must be positive"},
+		{name: "deprecation date", modify: func(c *Config) { c.API.V1Deprecation.Since = "18.10.2026" }, wantErr: "api.v1_deprecation.since: want YYYY-MM-DD"},
+		{name: "sunset before since", modify: func(c *Config) { c.API.V1Deprecation.Sunset = "2026-01-01" }, wantErr: "sunset: must be after since"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+			tt.modify(&cfg)
+
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Validate() = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateReportsAllErrors(t *testing.T) {
+	cfg := Default()
+	cfg.GRPC.Port = -1
+	cfg.Log.Format = "xml"
+	cfg.Filter.CacheSize = 0
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate() = nil")
+	}
+	for _, want := range []string{"grpc.port", "log.format", "filter.cache_size"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %s", err, want)
+		}
+	}
+
+	// Load не запускает сервер с такой конфигурацией.
+	if _, err := Load("test", []string{"-grpc.port", "-1"}); err == nil || !strings.Contains(err.Error(), "invalid config") {
+		t.Errorf("Load with invalid port = %v, want invalid config", err)
+	}
+}
+
+func TestRedacted(t *testing.T) {
+	cfg := Default()
+	cfg.TLS.CertFile = "/etc/ufo/server.crt"
+	cfg.TLS.KeyFile = "/etc/ufo/server.key"
+
+	r := cfg.Redacted()
+	if r.TLS.KeyFile != "******" {
+		t.Errorf("Redacted key_file = %q, want ******", r.TLS.KeyFile)
+	}
+	if r.TLS.CertFile != cfg.TLS.CertFile {
+		t.Errorf("Redacted cert_file = %q, want unchanged", r.TLS.CertFile)
+	}
+	if cfg.TLS.KeyFile != "/etc/ufo/server.key" {
+		t.Error("Redacted changed the original config")
+	}
+	if s := cfg.String(); strings.Contains(s, "server.key") {
+		t.Errorf("String() leaks key_file:\n%s", s)
+	}
+}
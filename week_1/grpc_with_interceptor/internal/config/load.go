@@ -0,0 +1,27 @@
+package config
+
+import (
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/serverconfig"
+)
+
+// Load собирает конфигурацию из значений по умолчанию, YAML файла, переменных
+// окружения и флагов командной строки (см. serverconfig.Load).
+func Load(name string, args []string) (Config, error) {
+	cfg := Default()
+	if err := serverconfig.Load(name, args, &cfg); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// Redacted возвращает копию конфигурации, в которой секреты заменены на звездочки.
+func (c Config) Redacted() Config {
+	out := c
+	serverconfig.Redact(&out)
+	return out
+}
+
+// String возвращает действующую конфигурацию в YAML без секретов.
+func (c Config) String() string {
+	return serverconfig.Format(c)
+}
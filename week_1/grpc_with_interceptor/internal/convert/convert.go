@@ -0,0 +1,141 @@
+// Package convert переводит наблюдения между версиями API. Хранилище держит
+// ufo.v1.Sighting, а сервис ufo.v2 работает с ним через функции этого пакета.
+// Перевод без потерь в обе стороны: v1 → v2 → v1 и v2 → v1 → v2 дают
+// исходное сообщение (для v2 — если продолжительность в целых секундах и
+// location задан).
+package convert
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/apperr"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	ufo_v2 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v2"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// SightingToV2 возвращает наблюдение в представлении ufo.v2. Вложенные
+// сообщения копируются, результат не разделяет память с s.
+func SightingToV2(s *ufo_v1.Sighting) *ufo_v2.Sighting {
+	if s == nil {
+		return nil
+	}
+	info := s.GetInfo()
+
+	out := &ufo_v2.Sighting{
+		Uuid:        s.GetUuid(),
+		ObservedAt:  proto.CloneOf(info.GetObservedAt()),
+		Description: info.GetDescription(),
+		Color:       ufo_v2.Color(info.GetNormalizedColor()),
+		Sound:       soundToV2(info.GetSound()),
+		CreateTime:  proto.CloneOf(s.GetCreatedAt()),
+		UpdateTime:  proto.CloneOf(s.GetUpdatedAt()),
+		DeleteTime:  proto.CloneOf(s.GetDeletedAt()),
+		// Место обязательно в обеих версиях, поэтому location есть всегда.
+		Location: &ufo_v2.Location{Name: info.GetLocation()},
+	}
+	if c := info.GetCoordinates(); c != nil {
+		out.Location.Coordinates = &ufo_v2.Coordinates{Latitude: c.GetLatitude(), Longitude: c.GetLongitude()}
+	}
+	if info.GetColor() != nil {
+		out.ColorText = proto.String(info.GetColor().GetValue())
+	}
+	if info.GetDurationSeconds() != nil {
+		out.Duration = durationpb.New(time.Duration(info.GetDurationSeconds().GetValue()) * time.Second)
+	}
+	return out
+}
+
+// SightingFromV2 возвращает наблюдение в представлении ufo.v1. Значения, не
+// представимые в v1 (дробная или слишком большая продолжительность,
+// неизвестное значение Sound), дают apperr.Validation с нарушением для поля
+// sighting.* — так наблюдение называется во всех запросах ufo.v2.
+func SightingFromV2(s *ufo_v2.Sighting) (*ufo_v1.Sighting, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	info := &ufo_v1.SightingInfo{
+		ObservedAt:      proto.CloneOf(s.GetObservedAt()),
+		Location:        s.GetLocation().GetName(),
+		Description:     s.GetDescription(),
+		NormalizedColor: ufo_v1.Color(s.GetColor()),
+	}
+	if c := s.GetLocation().GetCoordinates(); c != nil {
+		info.Coordinates = &ufo_v1.Coordinates{Latitude: c.GetLatitude(), Longitude: c.GetLongitude()}
+	}
+	if s.ColorText != nil {
+		info.Color = wrapperspb.String(s.GetColorText())
+	}
+
+	sound, err := soundFromV2(s.GetSound())
+	if err != nil {
+		return nil, err
+	}
+	info.Sound = sound
+
+	if s.GetDuration() != nil {
+		seconds, err := durationSeconds(s.GetDuration())
+		if err != nil {
+			return nil, err
+		}
+		info.DurationSeconds = wrapperspb.Int32(seconds)
+	}
+
+	return &ufo_v1.Sighting{
+		Uuid:      s.GetUuid(),
+		Info:      info,
+		CreatedAt: proto.CloneOf(s.GetCreateTime()),
+		UpdatedAt: proto.CloneOf(s.GetUpdateTime()),
+		DeletedAt: proto.CloneOf(s.GetDeleteTime()),
+	}, nil
+}
+
+func soundToV2(sound *wrapperspb.BoolValue) ufo_v2.Sound {
+	switch {
+	case sound == nil:
+		return ufo_v2.Sound_SOUND_UNSPECIFIED
+	case sound.GetValue():
+		return ufo_v2.Sound_SOUND_PRESENT
+	default:
+		return ufo_v2.Sound_SOUND_ABSENT
+	}
+}
+
+func soundFromV2(sound ufo_v2.Sound) (*wrapperspb.BoolValue, error) {
+	switch sound {
+	case ufo_v2.Sound_SOUND_UNSPECIFIED:
+		return nil, nil
+	case ufo_v2.Sound_SOUND_PRESENT:
+		return wrapperspb.Bool(true), nil
+	case ufo_v2.Sound_SOUND_ABSENT:
+		return wrapperspb.Bool(false), nil
+	default:
+		return nil, invalid("sighting.sound", fmt.Sprintf("unknown value %d", sound))
+	}
+}
+
+// durationSeconds переводит продолжительность в целое число секунд int32.
+func durationSeconds(d *durationpb.Duration) (int32, error) {
+	if !d.IsValid() {
+		return 0, invalid("sighting.duration", "value must be a valid duration")
+	}
+	if d.GetNanos() != 0 {
+		return 0, invalid("sighting.duration", "value must be a whole number of seconds")
+	}
+	if int64(int32(d.GetSeconds())) != d.GetSeconds() {
+		return 0, invalid("sighting.duration", "value is out of range")
+	}
+	return int32(d.GetSeconds()), nil
+}
+
+func invalid(field, reason string) error {
+	return apperr.Validation("sighting cannot be represented in API v1", &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: reason,
+	})
+}
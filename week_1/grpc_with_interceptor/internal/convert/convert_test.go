@@ -0,0 +1,196 @@
+package convert
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/apperr"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/fakedata"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	ufo_v2 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v2"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var created = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func TestRoundTripFromV1(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *ufo_v1.Sighting
+	}{
+		{
+			name: "required fields only",
+			s: &ufo_v1.Sighting{
+				Uuid:      uuid.NewString(),
+				Info:      &ufo_v1.SightingInfo{ObservedAt: timestamppb.New(created.Add(-time.Hour)), Location: "Roswell"},
+				CreatedAt: timestamppb.New(created),
+			},
+		},
+		{
+			name: "all fields",
+			s: &ufo_v1.Sighting{
+				Uuid: uuid.NewString(),
+				Info: &ufo_v1.SightingInfo{
+					ObservedAt:      timestamppb.New(created.Add(-time.Hour)),
+					Location:        "Roswell",
+					Description:     "светящийся диск",
+					Color:           wrapperspb.String("ярко-зеленый"),
+					Sound:           wrapperspb.Bool(true),
+					DurationSeconds: wrapperspb.Int32(86400),
+					NormalizedColor: ufo_v1.Color_COLOR_GREEN,
+					Coordinates:     &ufo_v1.Coordinates{Latitude: 33.39, Longitude: -104.52},
+				},
+				CreatedAt: timestamppb.New(created),
+				UpdatedAt: timestamppb.New(created.Add(time.Minute)),
+				DeletedAt: timestamppb.New(created.Add(time.Hour)),
+			},
+		},
+		{
+			name: "silent object with unrecognized color",
+			s: &ufo_v1.Sighting{
+				Uuid: uuid.NewString(),
+				Info: &ufo_v1.SightingInfo{
+					Location: "Area 51",
+					Color:    wrapperspb.String("мутный"),
+					Sound:    wrapperspb.Bool(false),
+				},
+			},
+		},
+		{
+			name: "coordinates at zero",
+			s: &ufo_v1.Sighting{
+				Uuid: uuid.NewString(),
+				Info: &ufo_v1.SightingInfo{Location: "Null Island", Coordinates: &ufo_v1.Coordinates{}},
+			},
+		},
+	}
+	for range 100 {
+		tests = append(tests, struct {
+			name string
+			s    *ufo_v1.Sighting
+		}{
+			name: "fakedata",
+			s:    &ufo_v1.Sighting{Uuid: uuid.NewString(), Info: fakedata.SightingInfo(), CreatedAt: timestamppb.Now()},
+		})
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v2 := SightingToV2(tt.s)
+			got, err := SightingFromV2(v2)
+			if err != nil {
+				t.Fatalf("SightingFromV2: %v", err)
+			}
+			if !proto.Equal(got, tt.s) {
+				t.Errorf("v1 -> v2 -> v1 mismatch:\n got: %v\nwant: %v", got, tt.s)
+			}
+		})
+	}
+}
+
+func TestRoundTripFromV2(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *ufo_v2.Sighting
+	}{
+		{
+			name: "required fields only",
+			s: &ufo_v2.Sighting{
+				Uuid:     uuid.NewString(),
+				Location: &ufo_v2.Location{Name: "Roswell"},
+			},
+		},
+		{
+			name: "all fields",
+			s: &ufo_v2.Sighting{
+				Uuid:        uuid.NewString(),
+				ObservedAt:  timestamppb.New(created.Add(-time.Hour)),
+				Location:    &ufo_v2.Location{Name: "Roswell", Coordinates: &ufo_v2.Coordinates{Latitude: -90, Longitude: 180}},
+				Description: "светящийся диск",
+				ColorText:   proto.String("#ff8800"),
+				Color:       ufo_v2.Color_COLOR_ORANGE,
+				Sound:       ufo_v2.Sound_SOUND_ABSENT,
+				Duration:    durationpb.New(90 * time.Second),
+				CreateTime:  timestamppb.New(created),
+				UpdateTime:  timestamppb.New(created.Add(time.Minute)),
+				DeleteTime:  timestamppb.New(created.Add(time.Hour)),
+			},
+		},
+		{
+			name: "empty color text",
+			s: &ufo_v2.Sighting{
+				Location:  &ufo_v2.Location{Name: "Roswell"},
+				ColorText: proto.String(""),
+				Sound:     ufo_v2.Sound_SOUND_PRESENT,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v1, err := SightingFromV2(tt.s)
+			if err != nil {
+				t.Fatalf("SightingFromV2: %v", err)
+			}
+			got := SightingToV2(v1)
+			if !proto.Equal(got, tt.s) {
+				t.Errorf("v2 -> v1 -> v2 mismatch:\n got: %v\nwant: %v", got, tt.s)
+			}
+		})
+	}
+}
+
+func TestSightingFromV2RejectsUnrepresentable(t *testing.T) {
+	tests := []struct {
+		name      string
+		s         *ufo_v2.Sighting
+		wantField string
+	}{
+		{
+			name:      "fractional duration",
+			s:         &ufo_v2.Sighting{Duration: durationpb.New(1500 * time.Millisecond)},
+			wantField: "sighting.duration",
+		},
+		{
+			name:      "duration overflows int32",
+			s:         &ufo_v2.Sighting{Duration: &durationpb.Duration{Seconds: 1 << 40}},
+			wantField: "sighting.duration",
+		},
+		{
+			name:      "unknown sound",
+			s:         &ufo_v2.Sighting{Sound: ufo_v2.Sound(42)},
+			wantField: "sighting.sound",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := SightingFromV2(tt.s)
+			var appErr *apperr.Error
+			if !errors.As(err, &appErr) || appErr.Kind != apperr.KindValidation {
+				t.Fatalf("err = %v, want validation error", err)
+			}
+			if len(appErr.Violations) != 1 || appErr.Violations[0].GetField() != tt.wantField {
+				t.Errorf("violations = %v, want field %s", appErr.Violations, tt.wantField)
+			}
+		})
+	}
+}
+
+// TestPalettesMatch проверяет, что палитры v1 и v2 совпадают по номерам:
+// конвертер переводит цвет приведением типа.
+func TestPalettesMatch(t *testing.T) {
+	if len(ufo_v1.Color_name) != len(ufo_v2.Color_name) {
+		t.Fatalf("palette sizes differ: v1 %d, v2 %d", len(ufo_v1.Color_name), len(ufo_v2.Color_name))
+	}
+	for number, name := range ufo_v1.Color_name {
+		if ufo_v2.Color_name[number] != name {
+			t.Errorf("color %d: v1 %s, v2 %s", number, name, ufo_v2.Color_name[number])
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package docs
+
+import (
+	_ "embed"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/swaggest/swgui"
+	"github.com/swaggest/swgui/v5emb"
+)
+
+// Пути спецификации и UI документации на gateway.
+const (
+	SpecPath   = "/openapi.json"
+	SpecV2Path = "/openapi.v2.json"
+	UIPath     = "/docs/"
+)
+
+// spec OpenAPI спецификация, сгенерированная protoc-gen-openapiv2 из ufo.proto
+// (task proto:gen). Описания операций и полей берутся из комментариев proto.
+//
+//go:embed openapi/ufo/v1/ufo.swagger.json
+var spec []byte
+
+// specV2 спецификация ufo.v2.UFOService.
+//
+//go:embed openapi/ufo/v2/ufo.swagger.json
+var specV2 []byte
+
+// Spec возвращает встроенную OpenAPI спецификацию UFO API.
+func Spec() []byte {
+	return spec
+}
+
+// apiSpec спецификация одной версии API для Swagger UI.
+type apiSpec struct {
+	name string
+	path string
+	body []byte
+}
+
+// RegisterHandlers добавляет в mux спецификации и Swagger UI по UIPath.
+// Спецификация v1 отдается по SpecPath всегда, v2 — по SpecV2Path, если
+// включен ufo.v2; тогда в верхней панели UI появляется выбор версии, и по
+// умолчанию открывается v2.
+func RegisterHandlers(mux *runtime.ServeMux, v2 bool) error {
+	specs := []apiSpec{{name: "ufo.v1", path: SpecPath, body: spec}}
+	if v2 {
+		specs = append(specs, apiSpec{name: "ufo.v2", path: SpecV2Path, body: specV2})
+	}
+
+	urls := make([]string, 0, len(specs))
+	for _, s := range specs {
+		body := s.body
+		if err := mux.HandlePath(http.MethodGet, s.path, func(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(body)
+		}); err != nil {
+			return err
+		}
+		urls = append(urls, fmt.Sprintf("{url: %q, name: %q}", s.path, s.name))
+	}
+
+	cfg := swgui.Config{
+		Title:       "UFO Service API",
+		SwaggerJSON: specs[len(specs)-1].path,
+		BasePath:    UIPath,
+	}
+	if len(specs) > 1 {
+		// Выбор спецификации Swagger UI показывает в верхней панели.
+		cfg.ShowTopBar = true
+		cfg.SettingsUI = map[string]string{
+			"urls":               "[" + strings.Join(urls, ", ") + "]",
+			`"urls.primaryName"`: fmt.Sprintf("%q", specs[len(specs)-1].name),
+		}
+	}
+	ui := v5emb.NewHandlerWithConfig(cfg)
+
+	if err := mux.HandlePath(http.MethodGet, "/docs", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		http.Redirect(w, r, UIPath, http.StatusMovedPermanently)
+	}); err != nil {
+		return err
+	}
+
+	return mux.HandlePath(http.MethodGet, UIPath+"{path=**}", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		ui.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,52 @@
+package fakedata
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	ufoV1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// SightingInfo генерирует случайное наблюдение НЛО за последние 3 года.
+// Опциональные поля (цвет, звук, продолжительность) заполняются случайно.
+func SightingInfo() *ufoV1.SightingInfo {
+	observedAt := gofakeit.DateRange(
+		time.Now().AddDate(-3, 0, 0), // за последние 3 года
+		time.Now(),
+	)
+	location := gofakeit.City() + ", " + gofakeit.StreetName()
+	description := gofakeit.Sentence(gofakeit.Number(5, 15))
+
+	info := &ufoV1.SightingInfo{
+		ObservedAt:  timestamppb.New(observedAt),
+		Location:    location,
+		Description: description,
+	}
+	if gofakeit.Bool() {
+		info.Color = wrapperspb.String(gofakeit.Color())
+	}
+
+	if gofakeit.Bool() {
+		info.Sound = wrapperspb.Bool(gofakeit.Bool())
+	}
+
+	if gofakeit.Bool() {
+		info.DurationSeconds = wrapperspb.Int32(int32(gofakeit.Number(1, 3600)))
+	}
+
+	return info
+}
+
+// SightingUpdateInfo генерирует случайное частичное обновление наблюдения:
+// новое описание и, с вероятностью 1/2, новый цвет.
+func SightingUpdateInfo() *ufoV1.SightingUpdateInfo {
+	info := &ufoV1.SightingUpdateInfo{
+		Description: wrapperspb.String(gofakeit.Sentence(gofakeit.Number(5, 15))),
+	}
+	if gofakeit.Bool() {
+		info.Color = wrapperspb.String(gofakeit.Color())
+	}
+	return info
+}
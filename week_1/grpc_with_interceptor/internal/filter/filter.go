@@ -0,0 +1,209 @@
+package filter
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common"
+	"github.com/google/cel-go/common/ast"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/interpreter"
+	"github.com/google/cel-go/parser"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/apperr"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// Значения по умолчанию для Options.
+const (
+	DefaultCostLimit = 1000
+	DefaultCacheSize = 256
+)
+
+// rootVar переменная со всем наблюдением; поля верхнего уровня
+// (uuid, info, deleted_at, ...) доступны и как отдельные переменные.
+const rootVar = "sighting"
+
+// sightingType полное имя типа сообщения, над которым вычисляются выражения.
+const sightingType = "ufo.v1.Sighting"
+
+// Options настройки компиляции фильтров.
+type Options struct {
+	// CostLimit ограничение стоимости вычисления выражения для одного
+	// наблюдения (в единицах стоимости CEL).
+	CostLimit uint64
+	// CacheSize сколько скомпилированных выражений держать в LRU кэше.
+	CacheSize int
+}
+
+// Compiler компилирует выражения CEL над ufo.v1.Sighting и кэширует
+// скомпилированные программы по тексту выражения.
+type Compiler struct {
+	env    *cel.Env
+	fields map[string]*types.FieldType
+	opts   Options
+
+	mu    sync.Mutex
+	cache map[string]*list.Element
+	lru   *list.List // *entry, недавно использованные в начале
+}
+
+type entry struct {
+	expr string
+	prg  *Program
+}
+
+// Program скомпилированное выражение фильтра.
+type Program struct {
+	prg    cel.Program
+	fields map[string]*types.FieldType
+}
+
+// NewCompiler создает окружение CEL с типами ufo.v1 и переменными для
+// каждого поля Sighting. Нулевые значения Options заменяются значениями по умолчанию.
+func NewCompiler(opts Options) (*Compiler, error) {
+	if opts.CostLimit == 0 {
+		opts.CostLimit = DefaultCostLimit
+	}
+	if opts.CacheSize <= 0 {
+		opts.CacheSize = DefaultCacheSize
+	}
+
+	desc := (&ufo_v1.Sighting{}).ProtoReflect().Descriptor()
+	env, err := cel.NewEnv(
+		cel.Types(&ufo_v1.Sighting{}),
+		cel.DeclareContextProto(desc),
+		cel.Variable(rootVar, cel.ObjectType(sightingType)),
+		cel.Macros(cel.GlobalMacro(parser.HasMacro.Function(), 1, hasField)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create CEL env: %w", err)
+	}
+
+	fields := make(map[string]*types.FieldType, desc.Fields().Len())
+	for i := range desc.Fields().Len() {
+		name := desc.Fields().Get(i).TextName()
+		ft, ok := env.CELTypeProvider().FindStructFieldType(sightingType, name)
+		if !ok {
+			return nil, fmt.Errorf("field %s not found in CEL type provider", name)
+		}
+		fields[name] = ft
+	}
+
+	return &Compiler{
+		env:    env,
+		fields: fields,
+		opts:   opts,
+		cache:  make(map[string]*list.Element),
+		lru:    list.New(),
+	}, nil
+}
+
+// hasField расширяет стандартный макрос has: has(deleted_at) для поля
+// верхнего уровня превращается в has(sighting.deleted_at), остальные
+// формы обрабатываются как обычно.
+func hasField(eh parser.ExprHelper, target ast.Expr, args []ast.Expr) (ast.Expr, *common.Error) {
+	if args[0].Kind() == ast.IdentKind {
+		name := args[0].AsIdent()
+		if (&ufo_v1.Sighting{}).ProtoReflect().Descriptor().Fields().ByTextName(name) != nil {
+			return eh.NewPresenceTest(eh.NewIdent(rootVar), name), nil
+		}
+	}
+	return parser.MakeHas(eh, target, args)
+}
+
+// Compile возвращает программу для выражения из кэша или компилирует ее.
+// Синтаксические ошибки, ошибки типов и небулев результат возвращаются
+// как apperr.Validation с нарушением для поля filter.
+func (c *Compiler) Compile(expr string) (*Program, error) {
+	c.mu.Lock()
+	if el, ok := c.cache[expr]; ok {
+		c.lru.MoveToFront(el)
+		c.mu.Unlock()
+		return el.Value.(*entry).prg, nil
+	}
+	c.mu.Unlock()
+
+	checked, iss := c.env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, invalidFilter(iss.Err().Error())
+	}
+	if !checked.OutputType().IsExactType(types.BoolType) {
+		return nil, invalidFilter(fmt.Sprintf("expression must evaluate to bool, got %s", checked.OutputType()))
+	}
+
+	prg, err := c.env.Program(checked, cel.CostLimit(c.opts.CostLimit))
+	if err != nil {
+		return nil, invalidFilter(err.Error())
+	}
+	p := &Program{prg: prg, fields: c.fields}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.cache[expr]; ok {
+		c.lru.MoveToFront(el)
+		return el.Value.(*entry).prg, nil
+	}
+	c.cache[expr] = c.lru.PushFront(&entry{expr: expr, prg: p})
+	if c.lru.Len() > c.opts.CacheSize {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.cache, oldest.Value.(*entry).expr)
+	}
+	return p, nil
+}
+
+// Match вычисляет выражение для наблюдения. Ошибка вычисления для одного
+// наблюдения (незаданное поле-обертка равно null, и null > 300 не
+// вычисляется; деление на ноль) и null вместо bool (выражение info.sound)
+// означают несовпадение, чтобы одна строка не ломала весь список.
+// Превышение лимита стоимости возвращается как apperr.Validation: это
+// свойство выражения, а не строки.
+func (p *Program) Match(s *ufo_v1.Sighting) (bool, error) {
+	out, _, err := p.prg.Eval(&activation{sighting: s, fields: p.fields})
+	var cancelled interpreter.EvalCancelledError
+	if errors.As(err, &cancelled) {
+		return false, invalidFilter(fmt.Sprintf("evaluate for sighting %s: %v", s.GetUuid(), err))
+	}
+	if err != nil {
+		return false, nil
+	}
+	matched, _ := out.Value().(bool)
+	return matched, nil
+}
+
+// activation отдает значения переменных наблюдения лениво: поле читается,
+// только если выражение к нему обращается.
+type activation struct {
+	sighting *ufo_v1.Sighting
+	fields   map[string]*types.FieldType
+}
+
+func (a *activation) ResolveName(name string) (any, bool) {
+	if name == rootVar {
+		return a.sighting, true
+	}
+	ft, ok := a.fields[name]
+	if !ok {
+		return nil, false
+	}
+	v, err := ft.GetFrom(a.sighting)
+	if err != nil {
+		return types.WrapErr(err), true
+	}
+	return v, true
+}
+
+func (a *activation) Parent() interpreter.Activation {
+	return nil
+}
+
+func invalidFilter(reason string) error {
+	return apperr.Validation("invalid filter", &errdetails.BadRequest_FieldViolation{
+		Field:       "filter",
+		Description: reason,
+	})
+}
@@ -0,0 +1,166 @@
+package filter
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/apperr"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func newCompiler(t *testing.T, opts Options) *Compiler {
+	t.Helper()
+
+	c, err := NewCompiler(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// long наблюдение со всеми полями-обертками.
+var long = &ufo_v1.Sighting{
+	Uuid: "long",
+	Info: &ufo_v1.SightingInfo{
+		Location:        "Roswell",
+		Color:           wrapperspb.String("green"),
+		Sound:           wrapperspb.Bool(true),
+		DurationSeconds: wrapperspb.Int32(600),
+	},
+}
+
+// bare наблюдение без полей-оберток, удаленное.
+var bare = &ufo_v1.Sighting{
+	Uuid:      "bare",
+	Info:      &ufo_v1.SightingInfo{Location: "Area 51"},
+	DeletedAt: timestamppb.Now(),
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		expr     string
+		wantLong bool
+		wantBare bool
+	}{
+		{expr: `info.duration_seconds > 300`, wantLong: true},
+		{expr: `info.duration_seconds < 300`},
+		{expr: `info.color == "green" && info.sound == true`, wantLong: true},
+		{expr: `info.location.startsWith("Area")`, wantBare: true},
+		{expr: `has(deleted_at)`, wantBare: true},
+		{expr: `!has(sighting.deleted_at)`, wantLong: true},
+		{expr: `has(info.duration_seconds) && info.duration_seconds > 300`, wantLong: true},
+		// Незаданная обертка равна null: сравнение не вычисляется, и строка
+		// просто не совпадает, вместо ошибки всего запроса.
+		{expr: `info.duration_seconds > 300 || has(deleted_at)`, wantLong: true, wantBare: true},
+		{expr: `100 / (info.duration_seconds - 600) > 0`},
+		// Обертка bool проходит проверку типов, а незаданная дает null.
+		{expr: `info.sound`, wantLong: true},
+	}
+
+	c := newCompiler(t, Options{})
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			p, err := c.Compile(tt.expr)
+			if err != nil {
+				t.Fatalf("Compile: %v", err)
+			}
+			for _, row := range []struct {
+				s    *ufo_v1.Sighting
+				want bool
+			}{{long, tt.wantLong}, {bare, tt.wantBare}} {
+				got, err := p.Match(row.s)
+				if err != nil {
+					t.Fatalf("Match(%s): %v", row.s.GetUuid(), err)
+				}
+				if got != row.want {
+					t.Errorf("Match(%s) = %v, want %v", row.s.GetUuid(), got, row.want)
+				}
+			}
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantMsg string
+	}{
+		{name: "syntax", expr: `info.duration_seconds >`, wantMsg: "Syntax error"},
+		{name: "unknown field", expr: `info.altitude > 10`, wantMsg: "undefined field"},
+		{name: "unknown variable", expr: `altitude > 10`, wantMsg: "undeclared reference"},
+		{name: "type mismatch", expr: `info.location > 10`, wantMsg: "no matching overload"},
+		{name: "non-bool result", expr: `info.location`, wantMsg: "must evaluate to bool"},
+	}
+
+	c := newCompiler(t, Options{})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := c.Compile(tt.expr)
+			if !errors.Is(err, apperr.ErrValidation) {
+				t.Fatalf("Compile(%q) error = %v, want validation error", tt.expr, err)
+			}
+			var appErr *apperr.Error
+			if !errors.As(err, &appErr) || len(appErr.Violations) != 1 ||
+				appErr.Violations[0].GetField() != "filter" ||
+				!strings.Contains(appErr.Violations[0].GetDescription(), tt.wantMsg) {
+				t.Errorf("Compile(%q) error = %+v, want filter violation containing %q", tt.expr, appErr, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestMatchCostLimit(t *testing.T) {
+	const expr = `[1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(x, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(y, x * y > 0))`
+
+	p, err := newCompiler(t, Options{CostLimit: 10}).Compile(expr)
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+	if _, err := p.Match(long); !errors.Is(err, apperr.ErrValidation) {
+		t.Fatalf("Match error = %v, want validation error for exceeded cost limit", err)
+	}
+
+	p, err = newCompiler(t, Options{}).Compile(expr)
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+	if ok, err := p.Match(long); err != nil || !ok {
+		t.Fatalf("Match with default limit = %v, %v; want true, nil", ok, err)
+	}
+}
+
+func TestCompileCache(t *testing.T) {
+	c := newCompiler(t, Options{CacheSize: 2})
+
+	compile := func(expr string) *Program {
+		t.Helper()
+		p, err := c.Compile(expr)
+		if err != nil {
+			t.Fatalf("Compile(%q): %v", expr, err)
+		}
+		return p
+	}
+
+	a := compile(`has(deleted_at)`)
+	if compile(`has(deleted_at)`) != a {
+		t.Fatal("second Compile of the same expression did not hit the cache")
+	}
+
+	b := compile(`info.sound == true`)
+	compile(`has(deleted_at)`) // a становится самым свежим
+	compile(`info.color == "red"`)
+
+	if compile(`has(deleted_at)`) != a {
+		t.Error("recently used expression was evicted")
+	}
+	if compile(`info.sound == true`) == b {
+		t.Error("least recently used expression was not evicted")
+	}
+	if c.lru.Len() != 2 || len(c.cache) != 2 {
+		t.Errorf("cache holds %d/%d entries, want 2", c.lru.Len(), len(c.cache))
+	}
+}
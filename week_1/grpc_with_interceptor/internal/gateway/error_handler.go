@@ -0,0 +1,173 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// RequestIDHeader заголовок с идентификатором запроса.
+const RequestIDHeader = "X-Request-Id"
+
+// fieldViolation описывает ошибку валидации одного поля в ответе gateway.
+type fieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+// errorBody единый формат тела ответа gateway при ошибке.
+type errorBody struct {
+	Code            int32             `json:"code"`
+	Reason          string            `json:"reason"`
+	Message         string            `json:"message"`
+	Details         []json.RawMessage `json:"details"`
+	FieldViolations []fieldViolation  `json:"field_violations,omitempty"`
+	RequestID       string            `json:"request_id"`
+}
+
+var detailsMarshaler = protojson.MarshalOptions{UseProtoNames: true}
+
+// fallbackMarshaler используется, если маршалер не передан.
+var fallbackMarshaler runtime.Marshaler = &runtime.JSONPb{}
+
+// fallbackError отдается, если маршалер не смог закодировать ни конверт, ни статус.
+const fallbackError = `{"code": 13, "reason": "INTERNAL", "message": "failed to marshal error message"}`
+
+// ErrorHandler обрабатывает ошибки gRPC для gateway и отдает их в едином
+// формате: код, причина из errdetails.ErrorInfo, сообщение, детали статуса и
+// идентификатор запроса. Тело кодирует marshaler, выбранный mux по Accept;
+// маршалеры только для proto сообщений (application/x-protobuf) получают
+// google.rpc.Status. runtime.HTTPStatusError задает HTTP код явно.
+func ErrorHandler(
+	_ context.Context,
+	_ *runtime.ServeMux,
+	marshaler runtime.Marshaler,
+	w http.ResponseWriter,
+	r *http.Request,
+	err error,
+) {
+	if marshaler == nil {
+		marshaler = fallbackMarshaler
+	}
+
+	var httpStatus *runtime.HTTPStatusError
+	if errors.As(err, &httpStatus) {
+		err = httpStatus.Err
+	}
+	st := statusFromError(r, err)
+
+	body := errorBody{
+		Code:      int32(st.Code()),
+		Reason:    code.Code(st.Code()).String(),
+		Message:   st.Message(),
+		Details:   make([]json.RawMessage, 0, len(st.Proto().GetDetails())),
+		RequestID: requestID(r),
+	}
+
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			body.Reason = d.GetReason()
+		case *errdetails.BadRequest:
+			for _, v := range d.GetFieldViolations() {
+				body.FieldViolations = append(body.FieldViolations, fieldViolation{
+					Field:       v.GetField(),
+					Description: v.GetDescription(),
+				})
+			}
+		}
+	}
+
+	for _, anyDetail := range st.Proto().GetDetails() {
+		raw, mErr := detailsMarshaler.Marshal(anyDetail)
+		if mErr != nil {
+			continue
+		}
+		body.Details = append(body.Details, raw)
+	}
+
+	var payload interface{} = body
+	buf, mErr := marshaler.Marshal(payload)
+	if mErr != nil {
+		payload = st.Proto()
+		buf, mErr = marshaler.Marshal(payload)
+	}
+
+	w.Header().Set(RequestIDHeader, body.RequestID)
+	if mErr != nil {
+		slog.ErrorContext(r.Context(), "failed to marshal error response", "error", mErr)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = io.WriteString(w, fallbackError)
+		return
+	}
+
+	httpCode := runtime.HTTPStatusFromCode(st.Code())
+	if httpStatus != nil {
+		httpCode = httpStatus.HTTPStatus
+	}
+	w.Header().Set("Content-Type", marshaler.ContentType(payload))
+	w.WriteHeader(httpCode)
+
+	if _, wErr := w.Write(buf); wErr != nil {
+		slog.ErrorContext(r.Context(), "failed to write error response", "error", wErr)
+	}
+}
+
+// statusFromError переводит ошибку в gRPC статус. Ошибки без статуса
+// (отмена запроса, превышение лимита тела) получают подходящий код, а
+// остальные — INTERNAL без текста исходной ошибки, который логируется.
+func statusFromError(r *http.Request, err error) *status.Status {
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+
+	var maxBytes *http.MaxBytesError
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err)
+	case errors.As(err, &maxBytes):
+		return status.Newf(codes.ResourceExhausted, "request body exceeds %d bytes", maxBytes.Limit)
+	default:
+		slog.ErrorContext(r.Context(), "unexpected gateway error", "error", err)
+		return status.New(codes.Internal, "internal server error")
+	}
+}
+
+// ErrorHandlerWithHeaders возвращает ErrorHandler, который перед ответом
+// передает клиенту заголовочные метаданные gRPC по правилам outgoing, как
+// grpc-gateway делает для успешных ответов (например, Deprecation устаревших
+// методов).
+func ErrorHandlerWithHeaders(outgoing runtime.HeaderMatcherFunc) runtime.ErrorHandlerFunc {
+	return func(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+		if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+			for key, values := range md.HeaderMD {
+				if h, ok := outgoing(key); ok {
+					for _, v := range values {
+						w.Header().Add(h, v)
+					}
+				}
+			}
+		}
+		ErrorHandler(ctx, mux, m, w, r, err)
+	}
+}
+
+// requestID возвращает идентификатор запроса из заголовка или генерирует новый.
+func requestID(r *http.Request) string {
+	if id := r.Header.Get(RequestIDHeader); id != "" {
+		return id
+	}
+	return uuid.NewString()
+}
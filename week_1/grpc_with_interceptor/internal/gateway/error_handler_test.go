@@ -0,0 +1,160 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/apperr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// envelope тело ошибки в том виде, как его читает клиент.
+type envelope struct {
+	Code            int32             `json:"code"`
+	Reason          string            `json:"reason"`
+	Message         string            `json:"message"`
+	Details         []json.RawMessage `json:"details"`
+	FieldViolations []fieldViolation  `json:"field_violations"`
+	RequestID       string            `json:"request_id"`
+}
+
+func handleError(t *testing.T, r *http.Request, err error) (*httptest.ResponseRecorder, envelope) {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	ErrorHandler(context.Background(), nil, nil, rec, r, err)
+
+	var body envelope
+	if jErr := json.Unmarshal(rec.Body.Bytes(), &body); jErr != nil {
+		t.Fatalf("decode %q: %v", rec.Body.String(), jErr)
+	}
+	return rec, body
+}
+
+func TestErrorHandlerEnvelope(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantHTTP    int
+		wantCode    int32
+		wantReason  string
+		wantDetails int
+		wantFields  []fieldViolation
+	}{
+		{
+			name:        "not found",
+			err:         apperr.NotFound("42"),
+			wantHTTP:    http.StatusNotFound,
+			wantCode:    5,
+			wantReason:  apperr.ReasonNotFound,
+			wantDetails: 2,
+		},
+		{
+			name:        "already deleted",
+			err:         apperr.AlreadyDeleted("42"),
+			wantHTTP:    http.StatusBadRequest,
+			wantCode:    9,
+			wantReason:  apperr.ReasonAlreadyDeleted,
+			wantDetails: 2,
+		},
+		{
+			name:        "conflict",
+			err:         apperr.Conflict("42", "version mismatch"),
+			wantHTTP:    http.StatusConflict,
+			wantCode:    6,
+			wantReason:  apperr.ReasonConflict,
+			wantDetails: 2,
+		},
+		{
+			name: "validation",
+			err: apperr.Validation("validation error", &errdetails.BadRequest_FieldViolation{
+				Field: "info.location", Description: "value length must be at least 1 runes",
+			}),
+			wantHTTP:    http.StatusBadRequest,
+			wantCode:    3,
+			wantReason:  apperr.ReasonValidationFailed,
+			wantDetails: 2,
+			wantFields:  []fieldViolation{{Field: "info.location", Description: "value length must be at least 1 runes"}},
+		},
+		{
+			name:        "explicit http status",
+			err:         &runtime.HTTPStatusError{HTTPStatus: http.StatusMethodNotAllowed, Err: apperr.NotFound("42")},
+			wantHTTP:    http.StatusMethodNotAllowed,
+			wantCode:    5,
+			wantReason:  apperr.ReasonNotFound,
+			wantDetails: 2,
+		},
+		{
+			name:       "body too large",
+			err:        &http.MaxBytesError{Limit: 1024},
+			wantHTTP:   http.StatusTooManyRequests,
+			wantCode:   8,
+			wantReason: "RESOURCE_EXHAUSTED",
+		},
+		{
+			name:       "canceled",
+			err:        context.Canceled,
+			wantHTTP:   499,
+			wantCode:   1,
+			wantReason: "CANCELLED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/api/v1/ufo/42", nil)
+			r.Header.Set(RequestIDHeader, "req-1")
+
+			rec, body := handleError(t, r, tt.err)
+			if rec.Code != tt.wantHTTP {
+				t.Errorf("HTTP status = %d, want %d", rec.Code, tt.wantHTTP)
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+				t.Errorf("Content-Type = %q, want application/json", ct)
+			}
+			if body.Code != tt.wantCode || body.Reason != tt.wantReason {
+				t.Errorf("code, reason = %d, %q; want %d, %q", body.Code, body.Reason, tt.wantCode, tt.wantReason)
+			}
+			if len(body.Details) != tt.wantDetails {
+				t.Errorf("details = %s, want %d entries", body.Details, tt.wantDetails)
+			}
+			if len(body.FieldViolations) != len(tt.wantFields) {
+				t.Fatalf("field_violations = %+v, want %+v", body.FieldViolations, tt.wantFields)
+			}
+			for i := range tt.wantFields {
+				if body.FieldViolations[i] != tt.wantFields[i] {
+					t.Errorf("field_violations[%d] = %+v, want %+v", i, body.FieldViolations[i], tt.wantFields[i])
+				}
+			}
+			if body.RequestID != "req-1" || rec.Header().Get(RequestIDHeader) != "req-1" {
+				t.Errorf("request id: body %q, header %q; want req-1", body.RequestID, rec.Header().Get(RequestIDHeader))
+			}
+		})
+	}
+}
+
+func TestErrorHandlerHidesInternalErrors(t *testing.T) {
+	const secret = "dial tcp 10.0.0.7:5432: password authentication failed"
+
+	rec, body := handleError(t, httptest.NewRequest(http.MethodGet, "/api/v1/ufo", nil), errors.New(secret))
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("HTTP status = %d, want 500", rec.Code)
+	}
+	if body.Code != 13 || body.Reason != "INTERNAL" || body.Message != "internal server error" {
+		t.Errorf("body = %+v, want INTERNAL with a generic message", body)
+	}
+	if strings.Contains(rec.Body.String(), "10.0.0.7") || strings.Contains(rec.Body.String(), "password") {
+		t.Errorf("response leaks the original error: %s", rec.Body.String())
+	}
+	// Без заголовка идентификатор генерируется и совпадает в теле и заголовке.
+	if body.RequestID == "" || rec.Header().Get(RequestIDHeader) != body.RequestID {
+		t.Errorf("request id: body %q, header %q", body.RequestID, rec.Header().Get(RequestIDHeader))
+	}
+}
@@ -0,0 +1,44 @@
+package gateway
+
+import (
+	"net/textproto"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// HeaderMatcher возвращает runtime.HeaderMatcherFunc, которая передает в gRPC
+// метаданные перечисленные заголовки и X-Request-Id под их именем в нижнем
+// регистре (X-Request-Id → x-request-id). Остальные заголовки обрабатываются
+// runtime.DefaultHeaderMatcher (Grpc-Metadata-* и разрешенные стандартные).
+func HeaderMatcher(headers []string) runtime.HeaderMatcherFunc {
+	forward := map[string]struct{}{RequestIDHeader: {}}
+	for _, h := range headers {
+		forward[textproto.CanonicalMIMEHeaderKey(h)] = struct{}{}
+	}
+
+	return func(key string) (string, bool) {
+		if _, ok := forward[textproto.CanonicalMIMEHeaderKey(key)]; ok {
+			return strings.ToLower(key), true
+		}
+		return runtime.DefaultHeaderMatcher(key)
+	}
+}
+
+// OutgoingHeaderMatcher возвращает runtime.HeaderMatcherFunc для метаданных
+// ответа: перечисленные ключи отдаются клиенту как HTTP заголовки под своим
+// именем (deprecation → Deprecation), остальные — с префиксом Grpc-Metadata-,
+// как в grpc-gateway по умолчанию.
+func OutgoingHeaderMatcher(headers []string) runtime.HeaderMatcherFunc {
+	plain := make(map[string]struct{}, len(headers))
+	for _, h := range headers {
+		plain[textproto.CanonicalMIMEHeaderKey(h)] = struct{}{}
+	}
+
+	return func(key string) (string, bool) {
+		if _, ok := plain[textproto.CanonicalMIMEHeaderKey(key)]; ok {
+			return textproto.CanonicalMIMEHeaderKey(key), true
+		}
+		return runtime.MetadataHeaderPrefix + key, true
+	}
+}
@@ -0,0 +1,62 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const healthCheckTimeout = 2 * time.Second
+
+type healthBody struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// RegisterHealthHandlers добавляет в mux эндпоинты /healthz и /readyz, которые
+// опрашивают grpc.health.v1. /healthz отражает общий статус сервера ("") —
+// живость процесса, не зависящую от проверок хранилища; /readyz — готовность
+// service. Оба отвечают 200 только в статусе SERVING и 503
+// при NOT_SERVING или недоступном gRPC сервере.
+func RegisterHealthHandlers(mux *runtime.ServeMux, client healthpb.HealthClient, service string) error {
+	if err := mux.HandlePath(http.MethodGet, "/healthz", healthHandler(client, "")); err != nil {
+		return err
+	}
+	return mux.HandlePath(http.MethodGet, "/readyz", healthHandler(client, service))
+}
+
+func healthHandler(client healthpb.HealthClient, service string) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		resp, err := check(r.Context(), client, service)
+		if err != nil {
+			writeHealth(w, http.StatusServiceUnavailable, healthBody{Status: "UNKNOWN", Error: err.Error()})
+			return
+		}
+
+		code := http.StatusOK
+		if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+			code = http.StatusServiceUnavailable
+		}
+		writeHealth(w, code, healthBody{Status: resp.GetStatus().String()})
+	}
+}
+
+func check(ctx context.Context, client healthpb.HealthClient, service string) (*healthpb.HealthCheckResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	return client.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+}
+
+func writeHealth(w http.ResponseWriter, code int, body healthBody) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		slog.Error("failed to write health response", "error", err)
+	}
+}
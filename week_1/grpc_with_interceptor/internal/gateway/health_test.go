@@ -0,0 +1,101 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestHealthHandlers(t *testing.T) {
+	const service = "ufo.v1.UFOService"
+
+	hs := health.NewServer()
+	s := grpc.NewServer()
+	healthpb.RegisterHealthServer(s, hs)
+
+	lis := NewInProcessListener()
+	go func() { _ = s.Serve(lis) }()
+
+	conn, err := DialInProcess(lis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	mux := runtime.NewServeMux()
+	if err := RegisterHealthHandlers(mux, healthpb.NewHealthClient(conn), service); err != nil {
+		t.Fatal(err)
+	}
+
+	get := func(path string) (int, healthBody) {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		var body healthBody
+		if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+			t.Fatalf("%s: decode %q: %v", path, rec.Body.String(), err)
+		}
+		return rec.Code, body
+	}
+
+	steps := []struct {
+		name        string
+		do          func()
+		wantHealthz int
+		wantReadyz  int
+		wantStatus  string
+	}{
+		{
+			// Сервис еще не зарегистрирован в health: /readyz не готов.
+			name:        "unknown service",
+			do:          func() {},
+			wantHealthz: http.StatusOK,
+			wantReadyz:  http.StatusServiceUnavailable,
+			wantStatus:  "UNKNOWN",
+		},
+		{
+			name:        "not ready",
+			do:          func() { hs.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING) },
+			wantHealthz: http.StatusOK,
+			wantReadyz:  http.StatusServiceUnavailable,
+			wantStatus:  "NOT_SERVING",
+		},
+		{
+			name:        "ready",
+			do:          func() { hs.SetServingStatus(service, healthpb.HealthCheckResponse_SERVING) },
+			wantHealthz: http.StatusOK,
+			wantReadyz:  http.StatusOK,
+			wantStatus:  "SERVING",
+		},
+		{
+			name:        "shutdown",
+			do:          hs.Shutdown,
+			wantHealthz: http.StatusServiceUnavailable,
+			wantReadyz:  http.StatusServiceUnavailable,
+			wantStatus:  "NOT_SERVING",
+		},
+		{
+			name:        "grpc server down",
+			do:          s.Stop,
+			wantHealthz: http.StatusServiceUnavailable,
+			wantReadyz:  http.StatusServiceUnavailable,
+			wantStatus:  "UNKNOWN",
+		},
+	}
+
+	for _, step := range steps {
+		step.do()
+		if code, _ := get("/healthz"); code != step.wantHealthz {
+			t.Errorf("%s: /healthz = %d, want %d", step.name, code, step.wantHealthz)
+		}
+		code, body := get("/readyz")
+		if code != step.wantReadyz || body.Status != step.wantStatus {
+			t.Errorf("%s: /readyz = %d %+v, want %d %s", step.name, code, body, step.wantReadyz, step.wantStatus)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package gateway
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// inProcessBufferSize размер буфера in-memory соединения gateway→gRPC.
+const inProcessBufferSize = 1 << 20
+
+// NewInProcessListener создает in-memory listener, на котором gRPC сервер
+// обслуживает gateway без сетевого loopback соединения. Запросы проходят
+// через тот же grpc.Server, поэтому все интерцепторы применяются как к
+// обычным gRPC клиентам.
+func NewInProcessListener() *bufconn.Listener {
+	return bufconn.Listen(inProcessBufferSize)
+}
+
+// DialInProcess создает клиентское соединение с gRPC сервером через lis. По
+// умолчанию соединение без TLS; если сервер настроен с TLS, передайте
+// grpc.WithTransportCredentials с теми же учетными данными, что для сети.
+func DialInProcess(lis *bufconn.Listener, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	opts = append([]grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}, opts...)
+
+	return grpc.NewClient("passthrough:///inprocess", opts...)
+}
@@ -0,0 +1,75 @@
+package gateway
+
+import (
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// MIME типы, которые gateway выбирает по заголовку Accept.
+const (
+	MIMEJSON     = "application/json"
+	MIMEProtobuf = "application/x-protobuf"
+	MIMENDJSON   = "application/x-ndjson"
+)
+
+// JSONOptions настройки JSON представления сообщений.
+type JSONOptions struct {
+	// EmitUnpopulated выводит поля с нулевыми значениями (description: "", sound: null).
+	EmitUnpopulated bool
+	// UseProtoNames использует имена полей из .proto (observed_at) вместо camelCase (observedAt).
+	UseProtoNames bool
+	// UseEnumNumbers выводит enum числами вместо имен.
+	UseEnumNumbers bool
+}
+
+// ProtoMarshaler отдает бинарный protobuf с Content-Type application/x-protobuf:
+// runtime.ProtoMarshaller отвечает application/octet-stream, по которому
+// клиент не отличит protobuf от произвольных байт.
+type ProtoMarshaler struct {
+	runtime.ProtoMarshaller
+}
+
+// ContentType возвращает MIME тип protobuf.
+func (m *ProtoMarshaler) ContentType(_ interface{}) string {
+	return MIMEProtobuf
+}
+
+// NDJSONMarshaler отдает потоковые ответы как newline-delimited JSON:
+// по одному сообщению {"result": ...} на строку.
+type NDJSONMarshaler struct {
+	*runtime.JSONPb
+}
+
+// ContentType возвращает MIME тип NDJSON.
+func (m *NDJSONMarshaler) ContentType(_ interface{}) string {
+	return MIMENDJSON
+}
+
+// Delimiter разделяет сообщения потока переводом строки.
+func (m *NDJSONMarshaler) Delimiter() []byte {
+	return []byte("\n")
+}
+
+// MarshalerOptions возвращает опции mux с маршалерами для согласования
+// содержимого: JSON с настройками opts по умолчанию, бинарный protobuf для
+// Accept: application/x-protobuf и NDJSON для Accept: application/x-ndjson.
+func MarshalerOptions(opts JSONOptions) []runtime.ServeMuxOption {
+	jsonPb := &runtime.JSONPb{
+		MarshalOptions: protojson.MarshalOptions{
+			EmitUnpopulated: opts.EmitUnpopulated,
+			UseProtoNames:   opts.UseProtoNames,
+			UseEnumNumbers:  opts.UseEnumNumbers,
+		},
+		UnmarshalOptions: protojson.UnmarshalOptions{
+			DiscardUnknown: true,
+		},
+	}
+	jsonMarshaler := &runtime.HTTPBodyMarshaler{Marshaler: jsonPb}
+
+	return []runtime.ServeMuxOption{
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, jsonMarshaler),
+		runtime.WithMarshalerOption(MIMEJSON, jsonMarshaler),
+		runtime.WithMarshalerOption(MIMEProtobuf, &ProtoMarshaler{}),
+		runtime.WithMarshalerOption(MIMENDJSON, &NDJSONMarshaler{JSONPb: jsonPb}),
+	}
+}
@@ -0,0 +1,187 @@
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeUFOServer отдает фиксированные наблюдения в Get и StreamAll.
+type fakeUFOServer struct {
+	ufo_v1.UnimplementedUFOServiceServer
+
+	sightings []*ufo_v1.Sighting
+}
+
+func (s *fakeUFOServer) Get(_ context.Context, req *ufo_v1.GetRequest) (*ufo_v1.GetResponse, error) {
+	return &ufo_v1.GetResponse{Sighting: s.sightings[0]}, nil
+}
+
+func (s *fakeUFOServer) StreamAll(_ *ufo_v1.GetAllRequest, stream ufo_v1.UFOService_StreamAllServer) error {
+	for _, sighting := range s.sightings {
+		if err := stream.Send(sighting); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var testSightings = []*ufo_v1.Sighting{
+	{
+		Uuid: "0b6c1f38-54c5-4a2f-9d63-3c1e7f7b2c11",
+		Info: &ufo_v1.SightingInfo{
+			ObservedAt: timestamppb.New(timestamppb.Now().AsTime().Truncate(1e9)),
+			Location:   "Розуэлл",
+		},
+	},
+	{
+		Uuid: "5d0f3a2e-8f1b-4c47-a5b9-0e9d7c6b4a21",
+		Info: &ufo_v1.SightingInfo{Location: "Петрозаводск"},
+	},
+}
+
+// newUFOGateway поднимает gateway с маршалерами MarshalerOptions перед
+// gRPC сервером в памяти процесса.
+func newUFOGateway(t *testing.T, opts JSONOptions) http.Handler {
+	t.Helper()
+
+	lis := NewInProcessListener()
+	s := grpc.NewServer()
+	ufo_v1.RegisterUFOServiceServer(s, &fakeUFOServer{sightings: testSightings})
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	conn, err := DialInProcess(lis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	mux := runtime.NewServeMux(MarshalerOptions(opts)...)
+	if err := ufo_v1.RegisterUFOServiceHandler(context.Background(), mux, conn); err != nil {
+		t.Fatal(err)
+	}
+	return mux
+}
+
+func serve(h http.Handler, path, accept string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, path, nil)
+	if accept != "" {
+		r.Header.Set("Accept", accept)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, r)
+	return rec
+}
+
+func TestMarshalerProtobuf(t *testing.T) {
+	h := newUFOGateway(t, JSONOptions{})
+
+	rec := serve(h, "/api/v1/ufo/"+testSightings[0].Uuid, MIMEProtobuf)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %q", rec.Code, rec.Body.String())
+	}
+	if got := rec.Header().Get("Content-Type"); got != MIMEProtobuf {
+		t.Errorf("Content-Type = %q, want %q", got, MIMEProtobuf)
+	}
+
+	var resp ufo_v1.GetResponse
+	if err := proto.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("body is not binary protobuf: %v", err)
+	}
+	if !proto.Equal(resp.GetSighting(), testSightings[0]) {
+		t.Errorf("sighting = %v, want %v", resp.GetSighting(), testSightings[0])
+	}
+}
+
+func TestMarshalerNDJSONStream(t *testing.T) {
+	h := newUFOGateway(t, JSONOptions{UseProtoNames: true})
+
+	rec := serve(h, "/api/v1/ufo:stream", MIMENDJSON)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %q", rec.Code, rec.Body.String())
+	}
+	if got := rec.Header().Get("Content-Type"); got != MIMENDJSON {
+		t.Errorf("Content-Type = %q, want %q", got, MIMENDJSON)
+	}
+
+	// Каждое сообщение потока — отдельная строка {"result": ...}.
+	var uuids []string
+	sc := bufio.NewScanner(rec.Body)
+	for sc.Scan() {
+		var line struct {
+			Result map[string]json.RawMessage `json:"result"`
+		}
+		if err := json.Unmarshal(sc.Bytes(), &line); err != nil {
+			t.Fatalf("line %q is not JSON: %v", sc.Text(), err)
+		}
+		var uuid string
+		if err := json.Unmarshal(line.Result["uuid"], &uuid); err != nil {
+			t.Fatalf("line %q has no uuid: %v", sc.Text(), err)
+		}
+		uuids = append(uuids, uuid)
+	}
+
+	if got, want := strings.Join(uuids, ","), testSightings[0].Uuid+","+testSightings[1].Uuid; got != want {
+		t.Errorf("streamed uuids = %s, want %s", got, want)
+	}
+}
+
+func TestMarshalerJSONOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    JSONOptions
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "proto names and unpopulated fields",
+			opts:    JSONOptions{EmitUnpopulated: true, UseProtoNames: true},
+			want:    []string{`"observed_at"`, `"sound":null`, `"description":""`},
+			notWant: []string{`"observedAt"`},
+		},
+		{
+			name:    "camelCase without unpopulated fields",
+			opts:    JSONOptions{},
+			want:    []string{`"observedAt"`},
+			notWant: []string{`"observed_at"`, `"sound"`, `"description"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newUFOGateway(t, tt.opts)
+
+			for _, accept := range []string{"", MIMEJSON} {
+				rec := serve(h, "/api/v1/ufo/"+testSightings[0].Uuid, accept)
+				if got := rec.Header().Get("Content-Type"); got != MIMEJSON {
+					t.Errorf("Accept %q: Content-Type = %q, want %q", accept, got, MIMEJSON)
+				}
+
+				body, _ := io.ReadAll(rec.Body)
+				compact := strings.ReplaceAll(string(body), " ", "")
+				for _, s := range tt.want {
+					if !strings.Contains(compact, s) {
+						t.Errorf("Accept %q: body %s does not contain %s", accept, body, s)
+					}
+				}
+				for _, s := range tt.notWant {
+					if strings.Contains(compact, s) {
+						t.Errorf("Accept %q: body %s contains %s", accept, body, s)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package gateway
+
+import (
+	"net/http"
+	"strings"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+// SinglePortHandler разделяет запросы одного listener между нативным gRPC и
+// REST gateway. gRPC определяется по HTTP/2 и content-type application/grpc
+// (gRPC-Web идет в restHandler). Для TLS HTTP/2 согласуется через ALPN, для
+// открытого порта используется h2c. Служебные эндпоинты сюда не входят: admin
+// всегда слушает отдельный порт.
+//
+// requireClientCert отклоняет gRPC вызовы без проверенного клиентского
+// сертификата: в режиме одного порта TLS запрашивает сертификат, но не
+// требует его, чтобы REST клиенты (браузеры) могли подключаться без mTLS.
+func SinglePortHandler(grpcHandler, restHandler http.Handler, plaintext, requireClientCert bool) http.Handler {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case isGRPCRequest(r):
+			if requireClientCert && (r.TLS == nil || len(r.TLS.VerifiedChains) == 0) {
+				w.Header().Set("Content-Type", "application/grpc")
+				w.Header().Set("Grpc-Status", "16")
+				w.Header().Set("Grpc-Message", "client certificate required")
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			grpcHandler.ServeHTTP(w, r)
+		default:
+			restHandler.ServeHTTP(w, r)
+		}
+	})
+
+	if plaintext {
+		return h2c.NewHandler(handler, &http2.Server{})
+	}
+	return handler
+}
+
+func isGRPCRequest(r *http.Request) bool {
+	if r.ProtoMajor != 2 {
+		return false
+	}
+	ct := r.Header.Get("Content-Type")
+	return ct == "application/grpc" || strings.HasPrefix(ct, "application/grpc+")
+}
@@ -0,0 +1,63 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/lifecycle"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// TestSinglePortStopWithOpenStream проверяет остановку сервера в режиме
+// одного порта: нативный gRPC поток через h2c открыт, а шаг остановки не
+// паникует и закрывает его по таймауту.
+func TestSinglePortStopWithOpenStream(t *testing.T) {
+	s := grpc.NewServer()
+	hs := health.NewServer()
+	hs.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(s, hs)
+
+	drain := lifecycle.NewHTTPDrain()
+	srv := httptest.NewServer(SinglePortHandler(drain.Wrap(s), http.NotFoundHandler(), true, false))
+	defer srv.Close()
+
+	conn, err := grpc.NewClient(strings.TrimPrefix(srv.URL, "http://"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	stream, err := healthpb.NewHealthClient(conn).Watch(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stream.Recv(); err != nil {
+		t.Fatalf("first watch message: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	if err := lifecycle.StopGRPC(s, drain)(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("StopGRPC = %v, want forced stop after deadline", err)
+	}
+
+	if _, err := stream.Recv(); err == nil {
+		t.Fatal("stream still open after forced stop")
+	}
+
+	// Новые вызовы после остановки получают UNAVAILABLE, а не зависают.
+	_, err = healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("Check after stop = %v, want Unavailable", err)
+	}
+}
@@ -0,0 +1,66 @@
+package gateway
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/vanguard/vanguardgrpc"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/lifecycle"
+	"google.golang.org/grpc"
+)
+
+// WebRPCAllowedHeaders заголовки запросов gRPC-Web и Connect, которые нужно
+// разрешить в CORS для браузерных клиентов.
+var WebRPCAllowedHeaders = []string{
+	"Content-Type",
+	"Connect-Protocol-Version",
+	"Connect-Timeout-Ms",
+	"Connect-Accept-Encoding",
+	"Connect-Content-Encoding",
+	"Grpc-Timeout",
+	"X-Grpc-Web",
+	"X-User-Agent",
+}
+
+// WebRPCExposedHeaders заголовки ответов gRPC-Web и Connect, которые браузер
+// должен отдавать клиенту.
+var WebRPCExposedHeaders = []string{
+	"Grpc-Status",
+	"Grpc-Message",
+	"Grpc-Status-Details-Bin",
+	"Connect-Content-Encoding",
+}
+
+// WebRPCHandler обслуживает протоколы gRPC-Web и Connect поверх HTTP/1.1 и
+// HTTP/2, транслируя вызовы в gRPC сервер server: работают те же реализации
+// сервисов и те же интерцепторы. Запросы к путям /<сервис>/<метод> для сервисов,
+// зарегистрированных в server, уходят в транслятор, остальные — в restHandler.
+//
+// Транслятор вызывает server.ServeHTTP синхронно, поэтому drain (может быть
+// nil) видит каждый такой вызов и позволяет дождаться их перед остановкой.
+func WebRPCHandler(server *grpc.Server, restHandler http.Handler, drain *lifecycle.HTTPDrain) (http.Handler, error) {
+	vanguard, err := vanguardgrpc.NewTranscoder(server)
+	if err != nil {
+		return nil, fmt.Errorf("create gRPC-Web/Connect transcoder: %w", err)
+	}
+	var transcoder http.Handler = vanguard
+	if drain != nil {
+		transcoder = drain.Wrap(transcoder)
+	}
+
+	prefixes := make([]string, 0, len(server.GetServiceInfo()))
+	for name := range server.GetServiceInfo() {
+		prefixes = append(prefixes, "/"+name+"/")
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(r.URL.Path, prefix) {
+				transcoder.ServeHTTP(w, r)
+				return
+			}
+		}
+		restHandler.ServeHTTP(w, r)
+	}), nil
+}
@@ -0,0 +1,144 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/lifecycle"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// newWebRPCServer поднимает gRPC сервер с grpc.health.v1 за транслятором
+// gRPC-Web/Connect. Health.Watch держит поток открытым, пока его не закроют.
+func newWebRPCServer(t *testing.T) (*grpc.Server, *lifecycle.HTTPDrain, *httptest.Server) {
+	t.Helper()
+
+	s := grpc.NewServer()
+	hs := health.NewServer()
+	hs.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(s, hs)
+
+	drain := lifecycle.NewHTTPDrain()
+	handler, err := WebRPCHandler(s, http.NotFoundHandler(), drain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	return s, drain, srv
+}
+
+// openWatch открывает поток Health.Watch по gRPC-Web и дочитывает первое
+// сообщение, после чего вызов гарантированно активен на сервере.
+func openWatch(ctx context.Context, t *testing.T, url string) *http.Response {
+	t.Helper()
+
+	msg, err := proto.Marshal(&healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	frame := make([]byte, 5, 5+len(msg))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(msg)))
+	frame = append(frame, msg...)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url+"/grpc.health.v1.Health/Watch", bytes.NewReader(frame))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/grpc-web+proto")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("watch status = %d, want 200", resp.StatusCode)
+	}
+
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(resp.Body, header); err != nil {
+		t.Fatalf("read first frame: %v", err)
+	}
+	if _, err := io.ReadFull(resp.Body, make([]byte, binary.BigEndian.Uint32(header[1:]))); err != nil {
+		t.Fatalf("read first message: %v", err)
+	}
+	return resp
+}
+
+func TestStopGRPCWithOpenWebStreamForcesStop(t *testing.T) {
+	s, drain, srv := newWebRPCServer(t)
+	resp := openWatch(context.Background(), t, srv.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	// Без drain GracefulStop паникует на открытом ServeHTTP транспорте.
+	err := lifecycle.StopGRPC(s, drain)(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("StopGRPC = %v, want forced stop after deadline", err)
+	}
+
+	// Stop закрывает поток: клиент дочитывает ответ до конца.
+	done := make(chan error, 1)
+	go func() {
+		_, err := io.Copy(io.Discard, resp.Body)
+		done <- err
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("web stream still open after forced stop")
+	}
+}
+
+func TestStopGRPCWaitsForWebStream(t *testing.T) {
+	s, drain, srv := newWebRPCServer(t)
+
+	streamCtx, closeStream := context.WithCancel(context.Background())
+	defer closeStream()
+	openWatch(streamCtx, t, srv.URL)
+
+	time.AfterFunc(50*time.Millisecond, closeStream)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := lifecycle.StopGRPC(s, drain)(ctx); err != nil {
+		t.Fatalf("StopGRPC = %v, want graceful stop once the stream is closed", err)
+	}
+}
+
+func TestWebRPCRejectsCallsAfterDrain(t *testing.T) {
+	_, drain, srv := newWebRPCServer(t)
+
+	if err := drain.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, srv.URL+"/grpc.health.v1.Health/Check", bytes.NewReader(make([]byte, 5)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/grpc-web+proto")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable || resp.Header.Get("Grpc-Status") != "14" {
+		t.Errorf("status = %d, grpc-status = %q, want 503 and 14", resp.StatusCode, resp.Header.Get("Grpc-Status"))
+	}
+}
@@ -0,0 +1,105 @@
+package health
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Probe проверяет готовность зависимости сервиса (например, хранилища).
+type Probe func(ctx context.Context) error
+
+// Service стандартный сервис grpc.health.v1 со статусом для каждого
+// зарегистрированного сервиса. Общий статус сервера ("") отражает живость
+// процесса: он SERVING до остановки и от Probe не зависит. Статус готовности
+// сервисов периодически обновляется по результату Probe. При остановке все
+// статусы переводятся в NOT_SERVING.
+type Service struct {
+	server *health.Server
+	// services сервисы, статус готовности которых задает Probe.
+	services []string
+	probe    Probe
+
+	mu           sync.Mutex
+	shuttingDown bool
+}
+
+// NewService создает сервис проверки здоровья для перечисленных gRPC сервисов.
+// Сервисы не готовы до первой успешной проверки в Run.
+func NewService(probe Probe, services ...string) *Service {
+	s := &Service{
+		server:   health.NewServer(),
+		services: services,
+		probe:    probe,
+	}
+
+	s.server.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	for _, name := range s.services {
+		s.server.SetServingStatus(name, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+
+	return s
+}
+
+// Register регистрирует grpc.health.v1.Health на gRPC сервере.
+func (s *Service) Register(server *grpc.Server) {
+	healthpb.RegisterHealthServer(server, s.server)
+}
+
+// Server возвращает реализацию grpc.health.v1.Health.
+func (s *Service) Server() healthpb.HealthServer {
+	return s.server
+}
+
+// Run выполняет Probe с заданным интервалом и обновляет статусы сервисов,
+// пока не будет отменен ctx.
+func (s *Service) Run(ctx context.Context, interval time.Duration) {
+	s.check(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.check(ctx)
+		}
+	}
+}
+
+// Shutdown переводит все сервисы в NOT_SERVING, чтобы балансировщик
+// перестал направлять новые запросы, и игнорирует дальнейшие проверки.
+func (s *Service) Shutdown() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.shuttingDown = true
+	s.server.Shutdown()
+}
+
+func (s *Service) check(ctx context.Context) {
+	status := healthpb.HealthCheckResponse_SERVING
+	if s.probe != nil {
+		if err := s.probe(ctx); err != nil {
+			slog.WarnContext(ctx, "⚠️ Health probe failed", "error", err)
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.shuttingDown {
+		return
+	}
+	for _, name := range s.services {
+		s.server.SetServingStatus(name, status)
+	}
+}
@@ -0,0 +1,103 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const readyService = "ufo.v1.UFOService"
+
+func statusOf(t *testing.T, s *Service, service string) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+
+	resp, err := s.Server().Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		t.Fatalf("Check(%q): %v", service, err)
+	}
+	return resp.GetStatus()
+}
+
+func TestServiceStatuses(t *testing.T) {
+	const (
+		serving    = healthpb.HealthCheckResponse_SERVING
+		notServing = healthpb.HealthCheckResponse_NOT_SERVING
+	)
+
+	var probeErr error
+	s := NewService(func(context.Context) error { return probeErr }, readyService)
+
+	steps := []struct {
+		name      string
+		do        func()
+		wantLive  healthpb.HealthCheckResponse_ServingStatus
+		wantReady healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{name: "before first check", do: func() {}, wantLive: serving, wantReady: notServing},
+		{name: "probe ok", do: func() { s.check(context.Background()) }, wantLive: serving, wantReady: serving},
+		{
+			name: "probe fails",
+			do: func() {
+				probeErr = errors.New("store unavailable")
+				s.check(context.Background())
+			},
+			// Сбой зависимости снимает готовность, но не живость процесса.
+			wantLive:  serving,
+			wantReady: notServing,
+		},
+		{
+			name: "probe recovers",
+			do: func() {
+				probeErr = nil
+				s.check(context.Background())
+			},
+			wantLive:  serving,
+			wantReady: serving,
+		},
+		{name: "shutdown", do: s.Shutdown, wantLive: notServing, wantReady: notServing},
+		{name: "check after shutdown", do: func() { s.check(context.Background()) }, wantLive: notServing, wantReady: notServing},
+	}
+
+	for _, step := range steps {
+		step.do()
+		if got := statusOf(t, s, ""); got != step.wantLive {
+			t.Errorf("%s: server status = %v, want %v", step.name, got, step.wantLive)
+		}
+		if got := statusOf(t, s, readyService); got != step.wantReady {
+			t.Errorf("%s: %s status = %v, want %v", step.name, readyService, got, step.wantReady)
+		}
+	}
+}
+
+func TestRunChecksImmediately(t *testing.T) {
+	probed := make(chan struct{}, 1)
+	s := NewService(func(context.Context) error {
+		select {
+		case probed <- struct{}{}:
+		default:
+		}
+		return nil
+	}, readyService)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx, time.Hour)
+		close(done)
+	}()
+
+	select {
+	case <-probed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not probe before the first tick")
+	}
+	cancel()
+	<-done
+
+	if got := statusOf(t, s, readyService); got != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("status after first check = %v, want SERVING", got)
+	}
+}
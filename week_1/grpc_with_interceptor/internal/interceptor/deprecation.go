@@ -0,0 +1,74 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// DeprecationHeaders ключи метаданных, которые выставляет DeprecationInterceptor.
+// Gateway передает их клиентам REST как обычные HTTP заголовки.
+var DeprecationHeaders = []string{"Deprecation", "Sunset", "Link"}
+
+// Deprecation описание устаревших методов для заголовков ответа:
+// Deprecation (RFC 9745), Sunset (RFC 8594) и Link с rel="successor-version".
+type Deprecation struct {
+	// Methods полные имена устаревших методов (/ufo.v1.UFOService/Create).
+	Methods []string
+	// Since момент, с которого методы считаются устаревшими.
+	Since time.Time
+	// Sunset момент отключения методов; нулевое значение — дата не назначена.
+	Sunset time.Time
+	// Successor URI версии API, которая заменяет устаревшую (опционально).
+	Successor string
+}
+
+func (d Deprecation) metadata() metadata.MD {
+	md := metadata.Pairs("deprecation", fmt.Sprintf("@%d", d.Since.Unix()))
+	if !d.Sunset.IsZero() {
+		md.Append("sunset", d.Sunset.UTC().Format(http.TimeFormat))
+	}
+	if d.Successor != "" {
+		md.Append("link", fmt.Sprintf("<%s>; rel=\"successor-version\"", d.Successor))
+	}
+	return md
+}
+
+// DeprecationInterceptor создает серверный унарный интерцептор, который
+// добавляет к ответам устаревших методов заголовки из d. Заголовки
+// отправляются и с ошибкой, и с успешным ответом.
+func DeprecationInterceptor(d Deprecation) grpc.UnaryServerInterceptor {
+	md := d.metadata()
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if slices.Contains(d.Methods, info.FullMethod) {
+			_ = grpc.SetHeader(ctx, md)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// DeprecationStreamInterceptor потоковый вариант DeprecationInterceptor.
+func DeprecationStreamInterceptor(d Deprecation) grpc.StreamServerInterceptor {
+	md := d.metadata()
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if slices.Contains(d.Methods, info.FullMethod) {
+			_ = ss.SetHeader(md)
+		}
+		return handler(srv, ss)
+	}
+}
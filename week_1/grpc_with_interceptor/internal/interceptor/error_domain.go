@@ -0,0 +1,54 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/apperr"
+	"google.golang.org/grpc"
+)
+
+// ErrorDomainInterceptor создает серверный унарный интерцептор, который
+// проставляет ошибкам apperr домен по proto пакету вызванного сервиса:
+// ufo.v1 для /ufo.v1.UFOService/Get, ufo.v2 для /ufo.v2.UFOService/GetSighting.
+// Хранилище и валидация общие для версий API и не знают, из какой версии их
+// вызвали, поэтому интерцептор должен стоять раньше валидации.
+func ErrorDomainInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, apperr.WithDomain(err, methodDomain(info.FullMethod))
+		}
+		return resp, nil
+	}
+}
+
+// ErrorDomainStreamInterceptor потоковый вариант ErrorDomainInterceptor.
+func ErrorDomainStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := handler(srv, ss); err != nil {
+			return apperr.WithDomain(err, methodDomain(info.FullMethod))
+		}
+		return nil
+	}
+}
+
+// methodDomain возвращает proto пакет сервиса из полного имени метода
+// (/ufo.v2.UFOService/GetSighting → ufo.v2).
+func methodDomain(fullMethod string) string {
+	service, _, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if i := strings.LastIndex(service, "."); i >= 0 {
+		return service[:i]
+	}
+	return service
+}
@@ -0,0 +1,90 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/apperr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+func TestMethodDomain(t *testing.T) {
+	tests := []struct {
+		fullMethod string
+		want       string
+	}{
+		{fullMethod: "/ufo.v1.UFOService/Get", want: "ufo.v1"},
+		{fullMethod: "/ufo.v2.UFOService/GetSighting", want: "ufo.v2"},
+		{fullMethod: "/grpc.health.v1.Health/Check", want: "grpc.health.v1"},
+		{fullMethod: "/Service/Method", want: "Service"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fullMethod, func(t *testing.T) {
+			if got := methodDomain(tt.fullMethod); got != tt.want {
+				t.Errorf("methodDomain(%q) = %q, want %q", tt.fullMethod, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorDomainInterceptors(t *testing.T) {
+	errPlain := errors.New("plain")
+
+	tests := []struct {
+		name       string
+		fullMethod string
+		err        error
+		wantDomain string
+	}{
+		{name: "v1", fullMethod: "/ufo.v1.UFOService/Get", err: apperr.NotFound("42"), wantDomain: apperr.DomainV1},
+		{name: "v2", fullMethod: "/ufo.v2.UFOService/GetSighting", err: apperr.NotFound("42"), wantDomain: apperr.DomainV2},
+		{name: "v2 validation", fullMethod: "/ufo.v2.UFOService/UpdateSighting", err: apperr.Validation("bad"), wantDomain: apperr.DomainV2},
+		{name: "not apperr", fullMethod: "/ufo.v2.UFOService/GetSighting", err: errPlain},
+		{name: "no error", fullMethod: "/ufo.v2.UFOService/GetSighting"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unary := ErrorDomainInterceptor()
+			_, unaryErr := unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: tt.fullMethod},
+				func(context.Context, interface{}) (interface{}, error) { return nil, tt.err })
+
+			stream := ErrorDomainStreamInterceptor()
+			streamErr := stream(nil, &fakeStream{}, &grpc.StreamServerInfo{FullMethod: tt.fullMethod},
+				func(interface{}, grpc.ServerStream) error { return tt.err })
+
+			for name, err := range map[string]error{"unary": unaryErr, "stream": streamErr} {
+				switch {
+				case tt.err == nil:
+					if err != nil {
+						t.Errorf("%s: err = %v, want nil", name, err)
+					}
+				case tt.wantDomain == "":
+					if err != tt.err {
+						t.Errorf("%s: err = %v, want the original error", name, err)
+					}
+				default:
+					if got := errorInfoDomain(err); got != tt.wantDomain {
+						t.Errorf("%s: ErrorInfo domain = %q, want %q", name, got, tt.wantDomain)
+					}
+					if status.Code(err) != status.Code(tt.err) {
+						t.Errorf("%s: code = %v, want %v", name, status.Code(err), status.Code(tt.err))
+					}
+				}
+			}
+		})
+	}
+}
+
+func errorInfoDomain(err error) string {
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return info.GetDomain()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,72 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// ClientIdentity данные клиента из проверенного сертификата mTLS.
+type ClientIdentity struct {
+	CommonName   string
+	DNSNames     []string
+	Organization []string
+	SerialNumber string
+}
+
+type identityKey struct{}
+
+// ClientIdentityFromContext возвращает идентичность клиента, сохраненную
+// IdentityInterceptor. ok == false, если клиент не предъявил сертификат.
+func ClientIdentityFromContext(ctx context.Context) (ClientIdentity, bool) {
+	id, ok := ctx.Value(identityKey{}).(ClientIdentity)
+	return id, ok
+}
+
+// IdentityInterceptor создает серверный унарный интерцептор, который извлекает
+// идентичность клиента из сертификата mTLS и кладет ее в контекст для
+// следующих интерцепторов и обработчиков.
+func IdentityInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		return handler(withClientIdentity(ctx), req)
+	}
+}
+
+// IdentityStreamInterceptor потоковый вариант IdentityInterceptor.
+func IdentityStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &contextStream{ServerStream: ss, ctx: withClientIdentity(ss.Context())})
+	}
+}
+
+func withClientIdentity(ctx context.Context) context.Context {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return ctx
+	}
+
+	cert := tlsInfo.State.VerifiedChains[0][0]
+	return context.WithValue(ctx, identityKey{}, ClientIdentity{
+		CommonName:   cert.Subject.CommonName,
+		DNSNames:     cert.DNSNames,
+		Organization: cert.Subject.Organization,
+		SerialNumber: cert.SerialNumber.String(),
+	})
+}
@@ -3,6 +3,7 @@ package interceptor
 import (
 	"context"
 	"log"
+	"log/slog"
 	"path"
 	"time"
 
@@ -23,7 +24,11 @@ func LoggerInterceptor() grpc.UnaryServerInterceptor {
 		method := path.Base(info.FullMethod)
 
 		// Логируем начало вызова метода
-		log.Printf("🚀 Started gRPC method %s\n", method)
+		if id, ok := ClientIdentityFromContext(ctx); ok {
+			slog.DebugContext(ctx, "🚀 Started gRPC method", "method", method, "client", id.CommonName)
+		} else {
+			slog.DebugContext(ctx, "🚀 Started gRPC method", "method", method)
+		}
 
 		// Засекаем время начала выполнения
 		startTime := time.Now()
@@ -37,9 +42,10 @@ func LoggerInterceptor() grpc.UnaryServerInterceptor {
 		// Форматируем сообщение в зависимости от результата
 		if err != nil {
 			st, _ := status.FromError(err)
-			log.Printf("❌ Finished gRPC method %s with code %s: %v (took: %v)\n", method, st.Code(), err, duration)
+			slog.WarnContext(ctx, "❌ Finished gRPC method with error",
+				"method", method, "code", st.Code(), "error", err, "took", duration)
 		} else {
-			log.Printf("✅ Finished gRPC method %s successfully (took: %v)\n", method, duration)
+			slog.InfoContext(ctx, "✅ Finished gRPC method", "method", method, "took", duration)
 		}
 
 		return resp, err
@@ -61,3 +67,58 @@ func LoggerInterceptor2() grpc.UnaryServerInterceptor {
 		return resp, err
 	}
 }
+
+// LoggerStreamInterceptor создает серверный потоковый интерцептор, который логирует
+// время выполнения потоковых методов и объем переданных в обе стороны данных.
+func LoggerStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		method := path.Base(info.FullMethod)
+
+		if id, ok := ClientIdentityFromContext(ss.Context()); ok {
+			slog.DebugContext(ss.Context(), "🚀 Started gRPC stream", "method", method, "client", id.CommonName)
+		} else {
+			slog.DebugContext(ss.Context(), "🚀 Started gRPC stream", "method", method)
+		}
+
+		startTime := time.Now()
+
+		stream := newMonitoredStream(ss)
+		err := handler(srv, stream)
+
+		duration := time.Since(startTime)
+		stats := stream.Stats()
+
+		if err != nil {
+			st, _ := status.FromError(err)
+			slog.WarnContext(ss.Context(), "❌ Finished gRPC stream with error",
+				"method", method, "code", st.Code(), "error", err, "took", duration,
+				"recv_msgs", stats.MsgsReceived, "recv_bytes", stats.BytesReceived,
+				"sent_msgs", stats.MsgsSent, "sent_bytes", stats.BytesSent)
+		} else {
+			slog.InfoContext(ss.Context(), "✅ Finished gRPC stream",
+				"method", method, "took", duration,
+				"recv_msgs", stats.MsgsReceived, "recv_bytes", stats.BytesReceived,
+				"sent_msgs", stats.MsgsSent, "sent_bytes", stats.BytesSent)
+		}
+
+		return err
+	}
+}
+
+func LoggerStreamInterceptor2() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		log.Printf("🚀 Bla\n")
+
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,96 @@
+package interceptor
+
+import (
+	"context"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// StreamStats счетчики сообщений и байт потока в обоих направлениях.
+type StreamStats struct {
+	MsgsReceived  int64
+	MsgsSent      int64
+	BytesReceived int64
+	BytesSent     int64
+}
+
+// monitoredStream оборачивает grpc.ServerStream и считает сообщения и байты,
+// прошедшие через поток в каждом направлении.
+type monitoredStream struct {
+	grpc.ServerStream
+
+	msgsReceived  atomic.Int64
+	msgsSent      atomic.Int64
+	bytesReceived atomic.Int64
+	bytesSent     atomic.Int64
+}
+
+func newMonitoredStream(ss grpc.ServerStream) *monitoredStream {
+	return &monitoredStream{ServerStream: ss}
+}
+
+func (s *monitoredStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	s.msgsReceived.Add(1)
+	s.bytesReceived.Add(int64(messageSize(m)))
+
+	return nil
+}
+
+func (s *monitoredStream) SendMsg(m interface{}) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+
+	s.msgsSent.Add(1)
+	s.bytesSent.Add(int64(messageSize(m)))
+
+	return nil
+}
+
+// Stats возвращает текущие значения счетчиков потока.
+func (s *monitoredStream) Stats() StreamStats {
+	return StreamStats{
+		MsgsReceived:  s.msgsReceived.Load(),
+		MsgsSent:      s.msgsSent.Load(),
+		BytesReceived: s.bytesReceived.Load(),
+		BytesSent:     s.bytesSent.Load(),
+	}
+}
+
+// recvHookStream вызывает hook для каждого успешно полученного сообщения.
+type recvHookStream struct {
+	grpc.ServerStream
+
+	hook func(m interface{}) error
+}
+
+func (s *recvHookStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.hook(m)
+}
+
+func messageSize(m interface{}) int {
+	if msg, ok := m.(proto.Message); ok {
+		return proto.Size(msg)
+	}
+	return 0
+}
+
+// contextStream подменяет контекст потока.
+type contextStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
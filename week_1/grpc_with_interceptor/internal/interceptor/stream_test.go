@@ -0,0 +1,235 @@
+package interceptor
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"io"
+	"log/slog"
+	"math/big"
+	"strings"
+	"testing"
+
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeStream отдает сообщения из recv, затем io.EOF, и сохраняет отправленные.
+// sendErr, если задана, возвращается из SendMsg.
+type fakeStream struct {
+	grpc.ServerStream
+
+	ctx     context.Context
+	recv    []proto.Message
+	sent    []interface{}
+	sendErr error
+}
+
+func (s *fakeStream) Context() context.Context {
+	if s.ctx == nil {
+		return context.Background()
+	}
+	return s.ctx
+}
+
+func (s *fakeStream) RecvMsg(m interface{}) error {
+	if len(s.recv) == 0 {
+		return io.EOF
+	}
+	proto.Merge(m.(proto.Message), s.recv[0])
+	s.recv = s.recv[1:]
+	return nil
+}
+
+func (s *fakeStream) SendMsg(m interface{}) error {
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestMonitoredStreamCounts(t *testing.T) {
+	in := []proto.Message{
+		&ufo_v1.GetAllRequest{Filter: "has(deleted_at)"},
+		&ufo_v1.GetAllRequest{},
+	}
+	out := &ufo_v1.Sighting{Uuid: validUUID, Info: &ufo_v1.SightingInfo{Location: "Roswell"}}
+
+	fake := &fakeStream{recv: in}
+	s := newMonitoredStream(fake)
+
+	for {
+		if err := s.RecvMsg(&ufo_v1.GetAllRequest{}); err != nil {
+			if err != io.EOF {
+				t.Fatal(err)
+			}
+			break
+		}
+	}
+	for range 3 {
+		if err := s.SendMsg(out); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Неудачные отправки не считаются.
+	fake.sendErr = errors.New("broken pipe")
+	if err := s.SendMsg(out); err == nil {
+		t.Fatal("SendMsg error = nil, want broken pipe")
+	}
+
+	want := StreamStats{
+		MsgsReceived:  2,
+		MsgsSent:      3,
+		BytesReceived: int64(proto.Size(in[0]) + proto.Size(in[1])),
+		BytesSent:     int64(3 * proto.Size(out)),
+	}
+	if got := s.Stats(); got != want {
+		t.Errorf("Stats() = %+v, want %+v", got, want)
+	}
+}
+
+func TestValidationStreamInterceptor(t *testing.T) {
+	tests := []struct {
+		name       string
+		recv       []proto.Message
+		wantFields []string
+		wantRecv   int
+	}{
+		{
+			name:     "valid messages",
+			recv:     []proto.Message{&ufo_v1.GetAllRequest{Filter: "true"}, &ufo_v1.GetAllRequest{}},
+			wantRecv: 2,
+		},
+		{
+			name:       "second message invalid",
+			recv:       []proto.Message{&ufo_v1.GetAllRequest{}, &ufo_v1.GetAllRequest{Filter: strings.Repeat("x", 1025)}},
+			wantFields: []string{"filter"},
+			wantRecv:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received := 0
+			handler := func(srv interface{}, ss grpc.ServerStream) error {
+				for {
+					if err := ss.RecvMsg(&ufo_v1.GetAllRequest{}); err != nil {
+						if err == io.EOF {
+							return nil
+						}
+						return err
+					}
+					received++
+				}
+			}
+
+			err := ValidationStreamInterceptor()(nil, &fakeStream{recv: tt.recv}, &grpc.StreamServerInfo{}, handler)
+			if received != tt.wantRecv {
+				t.Errorf("handler received %d messages, want %d", received, tt.wantRecv)
+			}
+			if len(tt.wantFields) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			fields := violations(t, err)
+			for _, f := range tt.wantFields {
+				if _, ok := fields[f]; !ok {
+					t.Errorf("violations = %v, want %s", fields, f)
+				}
+			}
+		})
+	}
+}
+
+func TestIdentityStreamInterceptor(t *testing.T) {
+	cert := &x509.Certificate{
+		Subject:      pkix.Name{CommonName: "ufo-client", Organization: []string{"Area 51"}},
+		DNSNames:     []string{"client.ufo.local"},
+		SerialNumber: big.NewInt(42),
+	}
+	withCert := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{
+		State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+	}})
+	withoutCert := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{}})
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		wantOK bool
+	}{
+		{name: "verified client certificate", ctx: withCert, wantOK: true},
+		{name: "tls without client certificate", ctx: withoutCert},
+		{name: "no peer", ctx: context.Background()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got ClientIdentity
+			var ok bool
+			handler := func(srv interface{}, ss grpc.ServerStream) error {
+				got, ok = ClientIdentityFromContext(ss.Context())
+				return nil
+			}
+
+			if err := IdentityStreamInterceptor()(nil, &fakeStream{ctx: tt.ctx}, &grpc.StreamServerInfo{}, handler); err != nil {
+				t.Fatal(err)
+			}
+			if ok != tt.wantOK {
+				t.Fatalf("identity found = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && (got.CommonName != "ufo-client" || got.SerialNumber != "42" ||
+				len(got.DNSNames) != 1 || len(got.Organization) != 1) {
+				t.Errorf("identity = %+v", got)
+			}
+		})
+	}
+}
+
+func TestLoggerStreamInterceptor(t *testing.T) {
+	var logs bytes.Buffer
+	prev := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&logs, nil)))
+	t.Cleanup(func() { slog.SetDefault(prev) })
+
+	out := &ufo_v1.Sighting{Uuid: validUUID}
+	info := &grpc.StreamServerInfo{FullMethod: "/ufo.v1.UFOService/StreamAll"}
+	wantErr := status.Error(codes.Unavailable, "store is closed")
+
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		if err := ss.RecvMsg(&ufo_v1.GetAllRequest{}); err != nil {
+			return err
+		}
+		for range 2 {
+			if err := ss.SendMsg(out); err != nil {
+				return err
+			}
+		}
+		return wantErr
+	}
+
+	fake := &fakeStream{recv: []proto.Message{&ufo_v1.GetAllRequest{}}}
+	if err := LoggerStreamInterceptor()(nil, fake, info, handler); err != wantErr {
+		t.Fatalf("error = %v, want handler error unchanged", err)
+	}
+	if len(fake.sent) != 2 {
+		t.Errorf("sent %d messages through the interceptor, want 2", len(fake.sent))
+	}
+
+	line := logs.String()
+	for _, want := range []string{"method=StreamAll", "code=Unavailable", "recv_msgs=1", "sent_msgs=2"} {
+		if !strings.Contains(line, want) {
+			t.Errorf("log %q does not contain %s", line, want)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/apperr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+)
+
+// validatorAll реализуется всеми сообщениями, сгенерированными protoc-gen-validate.
+type validatorAll interface {
+	ValidateAll() error
+}
+
+// validationError описывает одно нарушение правила protoc-gen-validate.
+type validationError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// multiError описывает набор нарушений, возвращаемый ValidateAll().
+type multiError interface {
+	AllErrors() []error
+}
+
+// Check дополнительная проверка запроса, которую нельзя выразить правилами
+// protoc-gen-validate. Возвращает нарушения по полям или nil, если запрос
+// другого типа или корректен.
+type Check func(req interface{}) []*errdetails.BadRequest_FieldViolation
+
+// ValidationInterceptor создает серверный унарный интерцептор, который проверяет
+// входящие запросы по правилам из .proto и проверкам checks и возвращает
+// codes.InvalidArgument с деталями errdetails.BadRequest по каждому невалидному
+// полю (см. apperr.Validation).
+func ValidationInterceptor(checks ...Check) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := validate(req, checks); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// ValidationStreamInterceptor создает серверный потоковый интерцептор, который
+// проверяет каждое входящее сообщение потока так же, как ValidationInterceptor.
+func ValidationStreamInterceptor(checks ...Check) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		stream := &recvHookStream{
+			ServerStream: ss,
+			hook: func(m interface{}) error {
+				return validate(m, checks)
+			},
+		}
+
+		return handler(srv, stream)
+	}
+}
+
+// validate собирает нарушения правил protoc-gen-validate и проверок checks
+// в одну доменную ошибку валидации с нарушениями по каждому полю.
+func validate(req interface{}, checks []Check) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+	if v, ok := req.(validatorAll); ok {
+		if err := v.ValidateAll(); err != nil {
+			pgv := fieldViolations("", err)
+			if len(pgv) == 0 {
+				return apperr.Validation("validation error: " + err.Error())
+			}
+			violations = append(violations, pgv...)
+		}
+	}
+	for _, check := range checks {
+		violations = append(violations, check(req)...)
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+	return apperr.Validation("validation error", violations...)
+}
+
+// fieldViolations рекурсивно разворачивает ошибки protoc-gen-validate
+// в плоский список нарушений с полными путями полей (например, info.location).
+func fieldViolations(prefix string, err error) []*errdetails.BadRequest_FieldViolation {
+	var multi multiError
+	if errors.As(err, &multi) {
+		var result []*errdetails.BadRequest_FieldViolation
+		for _, e := range multi.AllErrors() {
+			result = append(result, fieldViolations(prefix, e)...)
+		}
+		return result
+	}
+
+	var vErr validationError
+	if !errors.As(err, &vErr) {
+		return nil
+	}
+
+	field := joinField(prefix, toSnakeCase(vErr.Field()))
+	if cause := vErr.Cause(); cause != nil {
+		if nested := fieldViolations(field, cause); len(nested) > 0 {
+			return nested
+		}
+	}
+
+	return []*errdetails.BadRequest_FieldViolation{{
+		Field:       field,
+		Description: vErr.Reason(),
+	}}
+}
+
+func joinField(prefix, field string) string {
+	if prefix == "" {
+		return field
+	}
+	return prefix + "." + field
+}
+
+// toSnakeCase переводит имя Go-поля (DurationSeconds) в имя поля proto (duration_seconds).
+func toSnakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,138 @@
+package interceptor
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const validUUID = "3f0c6b8e-4a51-4d6e-9b7a-2f6f1f0b9c11"
+
+// violations возвращает поля и описания нарушений из errdetails.BadRequest.
+func violations(t *testing.T, err error) map[string]string {
+	t.Helper()
+
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("code = %v, want InvalidArgument (err: %v)", st.Code(), err)
+	}
+	fields := map[string]string{}
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.GetFieldViolations() {
+				fields[v.GetField()] = v.GetDescription()
+			}
+		}
+	}
+	return fields
+}
+
+func TestValidationInterceptor(t *testing.T) {
+	// locationCheck имитирует проверку, которую не выразить правилами .proto.
+	locationCheck := func(req interface{}) []*errdetails.BadRequest_FieldViolation {
+		if r, ok := req.(*ufo_v1.CreateRequest); ok && r.GetInfo().GetLocation() == "Area 51" {
+			return []*errdetails.BadRequest_FieldViolation{{Field: "info.location", Description: "classified"}}
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name       string
+		req        interface{}
+		wantFields []string
+	}{
+		{
+			name: "valid",
+			req:  &ufo_v1.CreateRequest{Info: &ufo_v1.SightingInfo{Location: "Roswell"}},
+		},
+		{
+			name:       "required message",
+			req:        &ufo_v1.CreateRequest{},
+			wantFields: []string{"info"},
+		},
+		{
+			name:       "nested fields in snake case",
+			req:        &ufo_v1.CreateRequest{Info: &ufo_v1.SightingInfo{DurationSeconds: wrapperspb.Int32(-1)}},
+			wantFields: []string{"info.duration_seconds", "info.location"},
+		},
+		{
+			name: "deeper nesting",
+			req: &ufo_v1.CreateRequest{Info: &ufo_v1.SightingInfo{
+				Location:    "Roswell",
+				Coordinates: &ufo_v1.Coordinates{Latitude: 91, Longitude: -181},
+			}},
+			wantFields: []string{"info.coordinates.latitude", "info.coordinates.longitude"},
+		},
+		{
+			name:       "check violation",
+			req:        &ufo_v1.CreateRequest{Info: &ufo_v1.SightingInfo{Location: "Area 51"}},
+			wantFields: []string{"info.location"},
+		},
+		{
+			name:       "rules of another request",
+			req:        &ufo_v1.UpdateRequest{Uuid: "42"},
+			wantFields: []string{"update_info", "uuid"},
+		},
+		{
+			// Сообщения без ValidateAll пропускаются как есть.
+			name: "not a validator",
+			req:  "plain string",
+		},
+	}
+
+	validate := ValidationInterceptor(locationCheck)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return "ok", nil
+			}
+
+			resp, err := validate(context.Background(), tt.req, &grpc.UnaryServerInfo{}, handler)
+			if len(tt.wantFields) == 0 {
+				if err != nil || !called || resp != "ok" {
+					t.Fatalf("resp, err = %v, %v (handler called %v), want handler response", resp, err, called)
+				}
+				return
+			}
+
+			if called {
+				t.Error("handler called for invalid request")
+			}
+			fields := violations(t, err)
+			got := make([]string, 0, len(fields))
+			for f, desc := range fields {
+				if desc == "" {
+					t.Errorf("violation %s has empty description", f)
+				}
+				got = append(got, f)
+			}
+			slices.Sort(got)
+			if !slices.Equal(got, tt.wantFields) {
+				t.Errorf("violations = %v, want %v", got, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestToSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"Uuid":            "uuid",
+		"DurationSeconds": "duration_seconds",
+		"UpdateInfo":      "update_info",
+		"info":            "info",
+	}
+	for in, want := range tests {
+		if got := toSnakeCase(in); got != want {
+			t.Errorf("toSnakeCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package lifecycle
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// HTTPDrain учитывает вызовы gRPC сервера, пришедшие через grpc.Server.ServeHTTP:
+// gRPC-Web и Connect через транслятор и нативный gRPC в режиме одного порта.
+// grpc-go не поддерживает GracefulStop для таких транспортов и паникует, если
+// они открыты, поэтому перед остановкой сервера HTTPDrain перестает принимать
+// новые вызовы и дожидается завершения активных.
+type HTTPDrain struct {
+	mu     sync.Mutex
+	active int
+	closed bool
+	idle   chan struct{}
+}
+
+// NewHTTPDrain создает HTTPDrain без активных вызовов.
+func NewHTTPDrain() *HTTPDrain {
+	return &HTTPDrain{idle: make(chan struct{})}
+}
+
+// Wrap оборачивает обработчик, который вызывает grpc.Server.ServeHTTP. После
+// Close новые вызовы получают 503 с gRPC статусом UNAVAILABLE.
+func (d *HTTPDrain) Wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !d.enter() {
+			w.Header().Set("Grpc-Status", "14")
+			w.Header().Set("Grpc-Message", "server is shutting down")
+			http.Error(w, "server is shutting down", http.StatusServiceUnavailable)
+			return
+		}
+		defer d.exit()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Close перестает принимать вызовы и ждет завершения активных. Возвращает
+// ошибку ctx, если вызовы не завершились до его отмены.
+func (d *HTTPDrain) Close(ctx context.Context) error {
+	d.mu.Lock()
+	if !d.closed {
+		d.closed = true
+		if d.active == 0 {
+			close(d.idle)
+		}
+	}
+	d.mu.Unlock()
+
+	select {
+	case <-d.idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (d *HTTPDrain) enter() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return false
+	}
+	d.active++
+	return true
+}
+
+func (d *HTTPDrain) exit() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.active--
+	if d.closed && d.active == 0 {
+		close(d.idle)
+	}
+}
@@ -0,0 +1,202 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+)
+
+// runner долгоживущий компонент (сервер), который работает до остановки.
+type runner struct {
+	name string
+	run  func() error
+}
+
+// stage шаг остановки, выполняемый с собственным таймаутом.
+type stage struct {
+	name    string
+	timeout time.Duration
+	fn      func(ctx context.Context) error
+}
+
+// Manager запускает серверы вместе, останавливает их по SIGINT/SIGTERM или
+// при первой фатальной ошибке любого из них и выполняет шаги остановки
+// строго в порядке регистрации.
+type Manager struct {
+	runners []runner
+	stages  []stage
+}
+
+// New создает пустой менеджер жизненного цикла.
+func New() *Manager {
+	return &Manager{}
+}
+
+// Go регистрирует компонент. Ошибка, возвращенная run, считается фатальной
+// и запускает остановку всех остальных компонентов.
+func (m *Manager) Go(name string, run func() error) {
+	m.runners = append(m.runners, runner{name: name, run: run})
+}
+
+// OnShutdown регистрирует шаг остановки. Шаги выполняются последовательно,
+// каждому дается timeout.
+func (m *Manager) OnShutdown(name string, timeout time.Duration, fn func(ctx context.Context) error) {
+	m.stages = append(m.stages, stage{name: name, timeout: timeout, fn: fn})
+}
+
+// Run запускает все компоненты и блокируется до их остановки. Возвращает
+// первую фатальную ошибку компонента и ошибки шагов остановки.
+func (m *Manager) Run(ctx context.Context) error {
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	g, gctx := errgroup.WithContext(ctx)
+
+	for _, r := range m.runners {
+		g.Go(func() error {
+			if err := r.run(); err != nil {
+				return fmt.Errorf("%s: %w", r.name, err)
+			}
+			return nil
+		})
+	}
+
+	var shutdownErr error
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		<-gctx.Done()
+		slog.Info("🛑 Shutting down...")
+		shutdownErr = m.shutdown()
+	}()
+
+	runErr := g.Wait()
+	<-done
+
+	return errors.Join(runErr, shutdownErr)
+}
+
+func (m *Manager) shutdown() error {
+	var errs []error
+
+	for _, st := range m.stages {
+		ctx, cancel := context.WithTimeout(context.Background(), st.timeout)
+		err := st.fn(ctx)
+		cancel()
+
+		if err != nil {
+			slog.Error("❌ Shutdown step failed", "step", st.name, "error", err)
+			errs = append(errs, fmt.Errorf("shutdown %s: %w", st.name, err))
+			continue
+		}
+		slog.Info("✅ Shutdown step done", "step", st.name)
+	}
+
+	return errors.Join(errs...)
+}
+
+// Unready возвращает шаг остановки, который снимает готовность через
+// setNotServing и ждет delay: балансировщик замечает NOT_SERVING не сразу,
+// и пока он не вывел инстанс, серверы должны принимать запросы. Шагу нужен
+// таймаут больше delay.
+func Unready(setNotServing func(), delay time.Duration) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		setNotServing()
+		if delay <= 0 {
+			return nil
+		}
+
+		t := time.NewTimer(delay)
+		defer t.Stop()
+
+		select {
+		case <-t.C:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// ServeGRPC возвращает функцию запуска gRPC сервера для Manager.Go.
+func ServeGRPC(s *grpc.Server, lis net.Listener) func() error {
+	return func() error {
+		if err := s.Serve(lis); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			return err
+		}
+		return nil
+	}
+}
+
+// StopGRPC возвращает шаг остановки gRPC сервера: GracefulStop дожидается
+// завершения активных вызовов, а по истечении таймаута (например, из-за
+// открытых потоков) соединения закрываются принудительно через Stop.
+// Если сервер обслуживает вызовы и через ServeHTTP, drain сначала дожидается
+// их, потому что GracefulStop с открытыми ServeHTTP транспортами паникует;
+// drain может быть nil.
+func StopGRPC(s *grpc.Server, drain *HTTPDrain) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		if drain != nil {
+			if err := drain.Close(ctx); err != nil {
+				s.Stop()
+				return fmt.Errorf("ServeHTTP calls still active, forced stop: %w", err)
+			}
+		}
+
+		stopped := make(chan struct{})
+		go func() {
+			s.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+			return nil
+		case <-ctx.Done():
+			s.Stop()
+			<-stopped
+			return fmt.Errorf("graceful stop timed out, forced stop: %w", ctx.Err())
+		}
+	}
+}
+
+// ServeHTTP возвращает функцию запуска HTTP сервера на готовом listener.
+// При заданном srv.TLSConfig сервер обслуживает HTTPS.
+func ServeHTTP(srv *http.Server, lis net.Listener) func() error {
+	return func() error {
+		var err error
+		if srv.TLSConfig != nil {
+			err = srv.ServeTLS(lis, "", "")
+		} else {
+			err = srv.Serve(lis)
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	}
+}
+
+// ShutdownHTTP возвращает шаг остановки HTTP сервера: Shutdown дожидается
+// завершения активных запросов, по таймауту соединения закрываются через Close.
+func ShutdownHTTP(srv *http.Server) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		if err := srv.Shutdown(ctx); err != nil {
+			if cerr := srv.Close(); cerr != nil {
+				return errors.Join(err, cerr)
+			}
+			return err
+		}
+		return nil
+	}
+}
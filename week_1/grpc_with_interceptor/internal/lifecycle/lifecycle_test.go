@@ -0,0 +1,271 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// recorder запоминает порядок выполнения шагов остановки.
+type recorder struct {
+	mu    sync.Mutex
+	steps []string
+}
+
+func (r *recorder) add(step string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.steps = append(r.steps, step)
+}
+
+func (r *recorder) String() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return strings.Join(r.steps, ",")
+}
+
+func runWithTimeout(t *testing.T, ctx context.Context, m *Manager) error {
+	t.Helper()
+
+	errc := make(chan error, 1)
+	go func() { errc <- m.Run(ctx) }()
+
+	select {
+	case err := <-errc:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return")
+		return nil
+	}
+}
+
+func TestRunStopsOnFirstError(t *testing.T) {
+	var rec recorder
+	stopped := make(chan struct{})
+	errBoom := errors.New("boom")
+
+	m := New()
+	m.Go("healthy", func() error {
+		<-stopped
+		rec.add("healthy exited")
+		return nil
+	})
+	m.Go("broken", func() error { return errBoom })
+	m.OnShutdown("stop healthy", time.Second, func(context.Context) error {
+		rec.add("stop healthy")
+		close(stopped)
+		return nil
+	})
+
+	err := runWithTimeout(t, context.Background(), m)
+	if !errors.Is(err, errBoom) || !strings.Contains(err.Error(), "broken: boom") {
+		t.Fatalf("Run() = %v, want broken: boom", err)
+	}
+	if got, want := rec.String(), "stop healthy,healthy exited"; got != want {
+		t.Errorf("steps = %q, want %q", got, want)
+	}
+}
+
+func TestRunStopsOnContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+
+	m := New()
+	m.Go("server", func() error {
+		<-stopped
+		return nil
+	})
+	m.OnShutdown("server", time.Second, func(context.Context) error {
+		close(stopped)
+		return nil
+	})
+
+	cancel()
+	if err := runWithTimeout(t, ctx, m); err != nil {
+		t.Fatalf("Run() = %v, want nil", err)
+	}
+}
+
+func TestShutdownStagesInOrderWithOwnTimeouts(t *testing.T) {
+	var rec recorder
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	m := New()
+	m.OnShutdown("readiness", time.Second, func(context.Context) error {
+		rec.add("readiness")
+		return nil
+	})
+	m.OnShutdown("gateway", 20*time.Millisecond, func(ctx context.Context) error {
+		rec.add("gateway")
+		// Зависший шаг ограничен своим таймаутом и не задерживает остальные.
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	m.OnShutdown("grpc", time.Hour, func(ctx context.Context) error {
+		rec.add("grpc")
+		deadline, ok := ctx.Deadline()
+		if !ok || time.Until(deadline) < time.Minute {
+			t.Errorf("grpc step deadline = %v, want its own timeout", time.Until(deadline))
+		}
+		return nil
+	})
+	m.OnShutdown("storage", time.Second, func(context.Context) error {
+		rec.add("storage")
+		return nil
+	})
+
+	err := runWithTimeout(t, ctx, m)
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "shutdown gateway") {
+		t.Fatalf("Run() = %v, want shutdown gateway deadline error", err)
+	}
+	if got, want := rec.String(), "readiness,gateway,grpc,storage"; got != want {
+		t.Errorf("steps = %q, want %q", got, want)
+	}
+}
+
+func TestUnready(t *testing.T) {
+	var calls int
+	setNotServing := func() { calls++ }
+
+	start := time.Now()
+	if err := Unready(setNotServing, 50*time.Millisecond)(context.Background()); err != nil {
+		t.Fatalf("Unready() = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("Unready returned after %v, want at least the delay", elapsed)
+	}
+
+	if err := Unready(setNotServing, 0)(context.Background()); err != nil {
+		t.Fatalf("Unready without delay = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := Unready(setNotServing, time.Hour)(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Unready past step timeout = %v, want deadline exceeded", err)
+	}
+
+	if calls != 3 {
+		t.Errorf("setNotServing called %d times, want 3", calls)
+	}
+}
+
+// startGRPC запускает gRPC сервер с health сервисом на bufconn.
+func startGRPC(t *testing.T) (*grpc.Server, healthpb.HealthClient, chan error) {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	healthpb.RegisterHealthServer(s, health.NewServer())
+
+	served := make(chan error, 1)
+	go func() { served <- ServeGRPC(s, lis)() }()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return s, healthpb.NewHealthClient(conn), served
+}
+
+func TestStopGRPCGraceful(t *testing.T) {
+	s, client, served := startGRPC(t)
+
+	if _, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{}); err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+
+	if err := StopGRPC(s, nil)(context.Background()); err != nil {
+		t.Fatalf("StopGRPC() = %v, want nil", err)
+	}
+	if err := <-served; err != nil {
+		t.Errorf("ServeGRPC() = %v, want nil after stop", err)
+	}
+}
+
+func TestStopGRPCFallsBackToStop(t *testing.T) {
+	s, client, served := startGRPC(t)
+
+	// Открытый Watch не дает GracefulStop завершиться.
+	stream, err := client.Watch(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	if _, err := stream.Recv(); err != nil {
+		t.Fatalf("Recv: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err = StopGRPC(s, nil)(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "forced stop") {
+		t.Fatalf("StopGRPC() = %v, want forced stop after timeout", err)
+	}
+	if _, err := stream.Recv(); err == nil {
+		t.Error("stream still open after forced stop")
+	}
+	if err := <-served; err != nil {
+		t.Errorf("ServeGRPC() = %v, want nil after stop", err)
+	}
+}
+
+func TestStopGRPCWaitsForHTTPDrain(t *testing.T) {
+	s, _, served := startGRPC(t)
+
+	drain := NewHTTPDrain()
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	h := drain.Wrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(entered)
+		<-release
+	}))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", nil))
+	}()
+	<-entered
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := StopGRPC(s, drain)(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "ServeHTTP calls still active") {
+		t.Fatalf("StopGRPC() = %v, want forced stop while ServeHTTP is active", err)
+	}
+	if err := <-served; err != nil {
+		t.Errorf("ServeGRPC() = %v, want nil after stop", err)
+	}
+
+	// После Close новые вызовы отклоняются с UNAVAILABLE.
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", nil))
+	if rec.Code != http.StatusServiceUnavailable || rec.Header().Get("Grpc-Status") != "14" {
+		t.Errorf("call after drain: code %d, grpc-status %q, want 503 and 14", rec.Code, rec.Header().Get("Grpc-Status"))
+	}
+
+	close(release)
+	<-done
+}
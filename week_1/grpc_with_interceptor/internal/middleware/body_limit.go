@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/gateway"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BodyLimit ограничивает размер тела запроса maxBytes байтами. Запросы с
+// заведомо большим Content-Length отклоняются сразу со статусом 413; ответ
+// кодирует маршалер mux, выбранный по Accept.
+func BodyLimit(mux *runtime.ServeMux, maxBytes int64) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ContentLength > maxBytes {
+				w.Header().Set("Connection", "close")
+				_, outbound := runtime.MarshalerForRequest(mux, r)
+				gateway.ErrorHandler(r.Context(), mux, outbound, w, r, &runtime.HTTPStatusError{
+					HTTPStatus: http.StatusRequestEntityTooLarge,
+					Err:        status.Errorf(codes.ResourceExhausted, "request body exceeds %d bytes", maxBytes),
+				})
+				return
+			}
+
+			r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
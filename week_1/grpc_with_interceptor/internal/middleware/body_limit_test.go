@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/gateway"
+)
+
+// errorBody поля единого формата ошибок gateway, которые проверяют тесты.
+type errorBody struct {
+	Code    int32  `json:"code"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+func newMux() *runtime.ServeMux {
+	return runtime.NewServeMux(gateway.MarshalerOptions(gateway.JSONOptions{})...)
+}
+
+func decodeError(t *testing.T, rec *httptest.ResponseRecorder) errorBody {
+	t.Helper()
+
+	var body errorBody
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode %q: %v", rec.Body.String(), err)
+	}
+	return body
+}
+
+func TestBodyLimitRejectsLargeContentLength(t *testing.T) {
+	var called bool
+	h := BodyLimit(newMux(), 16)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/ufo", strings.NewReader(strings.Repeat("x", 17)))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, r)
+
+	if called {
+		t.Error("handler called for oversized body")
+	}
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("status = %d, want 413", rec.Code)
+	}
+	if got := rec.Header().Get("Connection"); got != "close" {
+		t.Errorf("Connection = %q, want close", got)
+	}
+	body := decodeError(t, rec)
+	if body.Code != 8 || !strings.Contains(body.Message, "exceeds 16 bytes") {
+		t.Errorf("body = %+v, want RESOURCE_EXHAUSTED about 16 bytes", body)
+	}
+}
+
+func TestBodyLimitCapsStreamedBody(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    int
+		wantErr bool
+	}{
+		{name: "within limit", size: 16},
+		{name: "over limit", size: 17, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var readErr error
+			h := BodyLimit(newMux(), 16)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, readErr = io.ReadAll(r.Body)
+			}))
+
+			// Без Content-Length размер проверяется только при чтении.
+			r := httptest.NewRequest(http.MethodPost, "/v1/ufo", strings.NewReader(strings.Repeat("x", tt.size)))
+			r.ContentLength = -1
+			h.ServeHTTP(httptest.NewRecorder(), r)
+
+			var maxBytes *http.MaxBytesError
+			if got := errors.As(readErr, &maxBytes); got != tt.wantErr {
+				t.Errorf("read error = %v, want MaxBytesError: %v", readErr, tt.wantErr)
+			}
+		})
+	}
+}
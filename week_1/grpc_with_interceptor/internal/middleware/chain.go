@@ -0,0 +1,14 @@
+package middleware
+
+import "net/http"
+
+// Middleware оборачивает http.Handler дополнительной логикой.
+type Middleware func(next http.Handler) http.Handler
+
+// Chain применяет middleware к handler так, что первый в списке выполняется первым.
+func Chain(handler http.Handler, middlewares ...Middleware) http.Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	return handler
+}
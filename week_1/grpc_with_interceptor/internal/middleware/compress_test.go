@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCompress(t *testing.T) {
+	const payload = `{"sightings":[]}`
+
+	tests := []struct {
+		name           string
+		method         string
+		acceptEncoding string
+		contentType    string
+		status         int
+		encoding       string
+		wantGzip       bool
+		wantVary       bool
+	}{
+		{name: "gzip accepted", acceptEncoding: "gzip, deflate", wantGzip: true, wantVary: true},
+		{name: "gzip not accepted", acceptEncoding: "br", wantVary: true},
+		{name: "no accept-encoding", wantVary: true},
+		{name: "head request", method: http.MethodHead, acceptEncoding: "gzip", wantVary: true},
+		{name: "no content", acceptEncoding: "gzip", status: http.StatusNoContent, wantVary: true},
+		{name: "handler sets encoding", acceptEncoding: "gzip", encoding: "identity", wantVary: true},
+		{name: "grpc-web", acceptEncoding: "gzip", contentType: "application/grpc-web+proto"},
+		{name: "grpc-web text", acceptEncoding: "gzip", contentType: "application/grpc-web-text"},
+		{name: "grpc", acceptEncoding: "gzip", contentType: "application/grpc"},
+		{name: "connect streaming", acceptEncoding: "gzip", contentType: "application/connect+json"},
+		{name: "connect unary", acceptEncoding: "gzip", contentType: "application/json", wantGzip: true, wantVary: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := Compress(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.encoding != "" {
+					w.Header().Set("Content-Encoding", tt.encoding)
+				}
+				if tt.status != 0 {
+					w.WriteHeader(tt.status)
+					return
+				}
+				_, _ = io.WriteString(w, payload)
+			}))
+
+			method := tt.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			r := httptest.NewRequest(method, "/v1/ufo", nil)
+			if tt.acceptEncoding != "" {
+				r.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			}
+			if tt.contentType != "" {
+				r.Header.Set("Content-Type", tt.contentType)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, r)
+
+			if got := rec.Header().Get("Vary") == "Accept-Encoding"; got != tt.wantVary {
+				t.Errorf("Vary = %q, want Accept-Encoding: %v", rec.Header().Get("Vary"), tt.wantVary)
+			}
+			if got := rec.Header().Get("Content-Encoding") == "gzip"; got != tt.wantGzip {
+				t.Fatalf("Content-Encoding = %q, want gzip: %v", rec.Header().Get("Content-Encoding"), tt.wantGzip)
+			}
+			if !tt.wantGzip {
+				if tt.status == 0 && method != http.MethodHead && rec.Body.String() != payload {
+					t.Errorf("body = %q, want %q", rec.Body.String(), payload)
+				}
+				return
+			}
+
+			zr, err := gzip.NewReader(rec.Body)
+			if err != nil {
+				t.Fatalf("gzip.NewReader: %v", err)
+			}
+			body, err := io.ReadAll(zr)
+			if err != nil {
+				t.Fatalf("read gzip body: %v", err)
+			}
+			if string(body) != payload {
+				t.Errorf("body = %q, want %q", body, payload)
+			}
+		})
+	}
+}
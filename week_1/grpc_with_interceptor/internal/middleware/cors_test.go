@@ -0,0 +1,142 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCORS(t *testing.T) {
+	opts := CORSOptions{
+		AllowedOrigins: []string{"https://ufo.example"},
+		AllowedMethods: []string{"GET", "POST"},
+		AllowedHeaders: []string{"Content-Type", "X-Request-Id"},
+		ExposedHeaders: []string{"X-Request-Id"},
+		MaxAge:         10 * time.Minute,
+	}
+
+	tests := []struct {
+		name        string
+		opts        CORSOptions
+		method      string
+		header      map[string]string
+		wantStatus  int
+		wantNext    bool
+		wantHeaders map[string]string
+	}{
+		{
+			name:   "preflight from allowed origin",
+			opts:   opts,
+			method: http.MethodOptions,
+			header: map[string]string{
+				"Origin":                        "https://ufo.example",
+				"Access-Control-Request-Method": "POST",
+			},
+			wantStatus: http.StatusNoContent,
+			wantHeaders: map[string]string{
+				"Access-Control-Allow-Origin":      "https://ufo.example",
+				"Access-Control-Allow-Methods":     "GET, POST",
+				"Access-Control-Allow-Headers":     "Content-Type, X-Request-Id",
+				"Access-Control-Max-Age":           "600",
+				"Access-Control-Expose-Headers":    "",
+				"Access-Control-Allow-Credentials": "",
+			},
+		},
+		{
+			name:   "preflight from denied origin",
+			opts:   opts,
+			method: http.MethodOptions,
+			header: map[string]string{
+				"Origin":                        "https://evil.example",
+				"Access-Control-Request-Method": "POST",
+			},
+			wantStatus: http.StatusMethodNotAllowed,
+			wantNext:   true,
+			wantHeaders: map[string]string{
+				"Access-Control-Allow-Origin":  "",
+				"Access-Control-Allow-Methods": "",
+				"Vary":                         "Origin",
+			},
+		},
+		{
+			name:       "simple request from allowed origin",
+			opts:       opts,
+			method:     http.MethodGet,
+			header:     map[string]string{"Origin": "https://ufo.example"},
+			wantStatus: http.StatusMethodNotAllowed,
+			wantNext:   true,
+			wantHeaders: map[string]string{
+				"Access-Control-Allow-Origin":   "https://ufo.example",
+				"Access-Control-Expose-Headers": "X-Request-Id",
+				"Access-Control-Allow-Methods":  "",
+			},
+		},
+		{
+			name:       "request without origin",
+			opts:       opts,
+			method:     http.MethodGet,
+			wantStatus: http.StatusMethodNotAllowed,
+			wantNext:   true,
+			wantHeaders: map[string]string{
+				"Access-Control-Allow-Origin": "",
+				"Vary":                        "",
+			},
+		},
+		{
+			name:       "any origin",
+			opts:       CORSOptions{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}},
+			method:     http.MethodOptions,
+			header:     map[string]string{"Origin": "https://other.example", "Access-Control-Request-Method": "GET"},
+			wantStatus: http.StatusNoContent,
+			wantHeaders: map[string]string{
+				"Access-Control-Allow-Origin": "*",
+				"Access-Control-Max-Age":      "",
+			},
+		},
+		{
+			name: "credentials echo the origin",
+			opts: CORSOptions{
+				AllowedOrigins:   []string{"https://ufo.example"},
+				AllowedMethods:   []string{"GET"},
+				AllowCredentials: true,
+			},
+			method:     http.MethodOptions,
+			header:     map[string]string{"Origin": "https://ufo.example", "Access-Control-Request-Method": "GET"},
+			wantStatus: http.StatusNoContent,
+			wantHeaders: map[string]string{
+				"Access-Control-Allow-Origin":      "https://ufo.example",
+				"Access-Control-Allow-Credentials": "true",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called bool
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			})
+
+			r := httptest.NewRequest(tt.method, "/v1/ufo", nil)
+			for k, v := range tt.header {
+				r.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			CORS(tt.opts)(next).ServeHTTP(rec, r)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if called != tt.wantNext {
+				t.Errorf("next called = %v, want %v", called, tt.wantNext)
+			}
+			for k, want := range tt.wantHeaders {
+				if got := rec.Header().Get(k); got != want {
+					t.Errorf("%s = %q, want %q", k, got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/gateway"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Recoverer перехватывает панику в обработчике, логирует стек и отвечает
+// 500 в едином формате ошибок gateway, закодированном маршалером mux.
+func Recoverer(mux *runtime.ServeMux) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				rec := recover()
+				if rec == nil {
+					return
+				}
+				if rec == http.ErrAbortHandler {
+					panic(rec)
+				}
+
+				slog.ErrorContext(r.Context(), "💥 Panic in handler",
+					"method", r.Method, "path", r.URL.Path, "panic", rec, "stack", string(debug.Stack()))
+				_, outbound := runtime.MarshalerForRequest(mux, r)
+				gateway.ErrorHandler(r.Context(), mux, outbound, w, r, status.Error(codes.Internal, "internal server error"))
+			}()
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
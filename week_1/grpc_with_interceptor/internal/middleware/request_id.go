@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/gateway"
+)
+
+type requestIDKey struct{}
+
+// RequestIDFromContext возвращает идентификатор запроса, выставленный RequestID.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// RequestID берет идентификатор запроса из заголовка X-Request-Id или генерирует
+// новый. Идентификатор возвращается клиенту в ответе и остается в заголовках
+// запроса, откуда gateway передает его в gRPC метаданные x-request-id.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(gateway.RequestIDHeader)
+		if id == "" || len(id) > 128 {
+			id = uuid.NewString()
+			r.Header.Set(gateway.RequestIDHeader, id)
+		}
+
+		w.Header().Set(gateway.RequestIDHeader, id)
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestIDKey{}, id)))
+	})
+}
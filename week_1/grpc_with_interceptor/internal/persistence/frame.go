@@ -0,0 +1,61 @@
+package persistence
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// Формат записи на диске: длина payload (uint32 LE), CRC-32C payload
+// (uint32 LE), затем сам payload — сообщение protobuf.
+const (
+	frameHeaderSize = 8
+	// maxFrameSize защищает от выделения памяти по мусорной длине в поврежденном хвосте.
+	maxFrameSize = 64 << 20
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// errCorrupt означает недописанную или поврежденную запись.
+var errCorrupt = errors.New("corrupt record")
+
+func appendFrame(buf, payload []byte) []byte {
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(payload)))
+	buf = binary.LittleEndian.AppendUint32(buf, crc32.Checksum(payload, crcTable))
+	return append(buf, payload...)
+}
+
+// readFrame читает одну запись. Возвращает io.EOF, если данных больше нет,
+// и errCorrupt, если запись обрезана или не сходится контрольная сумма.
+func readFrame(r io.Reader) ([]byte, error) {
+	var header [frameHeaderSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, fmt.Errorf("%w: truncated header", errCorrupt)
+		}
+		return nil, err
+	}
+
+	size := binary.LittleEndian.Uint32(header[0:4])
+	sum := binary.LittleEndian.Uint32(header[4:8])
+	if size > maxFrameSize {
+		return nil, fmt.Errorf("%w: record size %d exceeds limit", errCorrupt, size)
+	}
+
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, fmt.Errorf("%w: truncated payload", errCorrupt)
+		}
+		return nil, err
+	}
+	if crc32.Checksum(payload, crcTable) != sum {
+		return nil, fmt.Errorf("%w: checksum mismatch", errCorrupt)
+	}
+	return payload, nil
+}
@@ -0,0 +1,86 @@
+package persistence
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	storage_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/storage/v1"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const snapshotFile = "snapshot.pb"
+
+// writeSnapshot атомарно записывает снимок: во временный файл с fsync,
+// затем rename и fsync каталога.
+func writeSnapshot(dir string, sequence uint64, sightings []*ufo_v1.Sighting) error {
+	payload, err := proto.Marshal(&storage_v1.Snapshot{
+		Sequence:  sequence,
+		CreatedAt: timestamppb.Now(),
+		Sightings: sightings,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal snapshot: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, snapshotFile+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("create snapshot: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(appendFrame(nil, payload)); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write snapshot: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("sync snapshot: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close snapshot: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), filepath.Join(dir, snapshotFile)); err != nil {
+		return fmt.Errorf("install snapshot: %w", err)
+	}
+	return syncDir(dir)
+}
+
+// readSnapshot читает снимок. Если снимка нет, возвращает пустой снимок.
+// Снимок пишется атомарно, поэтому его повреждение — ошибка, а не хвост.
+func readSnapshot(dir string) (*storage_v1.Snapshot, error) {
+	f, err := os.Open(filepath.Join(dir, snapshotFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return &storage_v1.Snapshot{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open snapshot: %w", err)
+	}
+	defer f.Close()
+
+	payload, err := readFrame(bufio.NewReader(f))
+	if err != nil {
+		return nil, fmt.Errorf("read snapshot %s: %w", f.Name(), err)
+	}
+
+	snapshot := &storage_v1.Snapshot{}
+	if err := proto.Unmarshal(payload, snapshot); err != nil {
+		return nil, fmt.Errorf("decode snapshot %s: %w", f.Name(), err)
+	}
+	return snapshot, nil
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
@@ -0,0 +1,427 @@
+package persistence
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	storage_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/storage/v1"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Политики fsync журнала.
+const (
+	// FsyncAlways fsync после каждой записи: мутация подтверждается клиенту
+	// только после попадания на диск.
+	FsyncAlways = "always"
+	// FsyncInterval fsync в фоне раз в FsyncInterval: при сбое ОС теряются
+	// записи за последний интервал.
+	FsyncInterval = "interval"
+	// FsyncNever сброс на диск остается ОС (и происходит при снимке и закрытии).
+	FsyncNever = "never"
+)
+
+// Options настройки журнала и снимков.
+type Options struct {
+	Dir              string
+	Fsync            string
+	FsyncInterval    time.Duration
+	SnapshotInterval time.Duration
+}
+
+// ErrClosed возвращается при записи в закрытый журнал.
+var ErrClosed = errors.New("wal is closed")
+
+// segmentFile открытый на запись сегмент журнала. В тестах подменяется,
+// чтобы имитировать ошибки диска.
+type segmentFile interface {
+	io.Writer
+	Sync() error
+	Truncate(size int64) error
+	Close() error
+}
+
+// Log журнал упреждающей записи (WAL) мутаций хранилища и снимки состояния.
+//
+// Журнал разбит на сегменты wal-<первый sequence>.log. Снимок переключает
+// запись на новый сегмент, сохраняет состояние на момент переключения и
+// удаляет старые сегменты (компактизация). При старте состояние
+// восстанавливается из снимка и записей сегментов с большим sequence.
+// Log реализует store.Journal.
+type Log struct {
+	opts Options
+
+	mu      sync.Mutex
+	f       segmentFile
+	offset  int64
+	segment uint64
+	seq     uint64
+	dirty   bool
+	closed  bool
+	// failed ошибка отката незавершенной записи: хвост сегмента неизвестен,
+	// и дописывать в него нельзя до перезапуска, который обрежет хвост при
+	// восстановлении.
+	failed error
+
+	snapshotMu sync.Mutex
+}
+
+// Open восстанавливает состояние из каталога opts.Dir и открывает журнал
+// на запись. Поврежденный хвост последнего сегмента (обрезанная запись или
+// несовпадение контрольной суммы) — след сбоя во время записи и отбрасывается
+// с предупреждением в логе. Повреждение в более раннем сегменте означает
+// потерю подтвержденных мутаций, поэтому Open возвращает ошибку.
+func Open(opts Options) (*Log, []*ufo_v1.Sighting, error) {
+	if err := os.MkdirAll(opts.Dir, 0o755); err != nil {
+		return nil, nil, fmt.Errorf("create storage dir: %w", err)
+	}
+
+	snapshot, err := readSnapshot(opts.Dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	state := make(map[string]*ufo_v1.Sighting, len(snapshot.GetSightings()))
+	for _, s := range snapshot.GetSightings() {
+		state[s.GetUuid()] = s
+	}
+
+	segments, err := listSegments(opts.Dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	seq := snapshot.GetSequence()
+	replayed := 0
+	for i, start := range segments {
+		tail := i == len(segments)-1
+		last, n, err := replaySegment(segmentPath(opts.Dir, start), snapshot.GetSequence(), tail, state)
+		if err != nil {
+			return nil, nil, err
+		}
+		seq = max(seq, last)
+		replayed += n
+	}
+
+	l := &Log{opts: opts, seq: seq}
+	if err := l.openSegment(seq + 1); err != nil {
+		return nil, nil, err
+	}
+
+	sightings := make([]*ufo_v1.Sighting, 0, len(state))
+	for _, s := range state {
+		sightings = append(sightings, s)
+	}
+
+	slog.Info("💾 Restored sightings", "count", len(sightings), "dir", opts.Dir,
+		"snapshot_seq", snapshot.GetSequence(), "replayed", replayed)
+
+	return l, sightings, nil
+}
+
+// Created записывает создание наблюдения.
+func (l *Log) Created(sighting *ufo_v1.Sighting) error {
+	return l.append(&storage_v1.WALRecord{Mutation: &storage_v1.WALRecord_Create{Create: sighting}})
+}
+
+// Updated записывает новую версию наблюдения.
+func (l *Log) Updated(sighting *ufo_v1.Sighting) error {
+	return l.append(&storage_v1.WALRecord{Mutation: &storage_v1.WALRecord_Update{Update: sighting}})
+}
+
+// Deleted записывает окончательное удаление наблюдения.
+func (l *Log) Deleted(uuid string) error {
+	return l.append(&storage_v1.WALRecord{Mutation: &storage_v1.WALRecord_Delete{Delete: uuid}})
+}
+
+func (l *Log) append(rec *storage_v1.WALRecord) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return ErrClosed
+	}
+	if l.failed != nil {
+		return fmt.Errorf("wal is unusable after failed rollback: %w", l.failed)
+	}
+
+	rec.Sequence = l.seq + 1
+	rec.WrittenAt = timestamppb.Now()
+	payload, err := proto.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("marshal wal record: %w", err)
+	}
+
+	frame := appendFrame(make([]byte, 0, frameHeaderSize+len(payload)), payload)
+	if _, err := l.f.Write(frame); err != nil {
+		l.rollback()
+		return fmt.Errorf("append wal record: %w", err)
+	}
+
+	if l.opts.Fsync == FsyncAlways {
+		// Клиент получит ошибку, поэтому запись не должна пережить перезапуск
+		// и занимать свой sequence.
+		if err := l.f.Sync(); err != nil {
+			l.rollback()
+			return fmt.Errorf("sync wal: %w", err)
+		}
+	} else {
+		l.dirty = true
+	}
+
+	l.offset += int64(len(frame))
+	l.seq++
+	return nil
+}
+
+// rollback отбрасывает неподтвержденную запись, обрезая сегмент до
+// l.offset, чтобы следующая запись начиналась с границы. Если обрезать не
+// удалось, журнал перестает принимать записи. Вызывается под l.mu.
+func (l *Log) rollback() {
+	if err := l.f.Truncate(l.offset); err != nil {
+		l.failed = err
+		slog.Error("❌ WAL rollback failed, journal is read-only until restart", "offset", l.offset, "error", err)
+	}
+}
+
+// Sync сбрасывает на диск записи, накопленные с прошлого fsync.
+func (l *Log) Sync() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed || !l.dirty {
+		return nil
+	}
+	if err := l.f.Sync(); err != nil {
+		return fmt.Errorf("sync wal: %w", err)
+	}
+	l.dirty = false
+	return nil
+}
+
+// Snapshot сохраняет снимок состояния, полученного из list, и удаляет
+// сегменты журнала, записи которых в него вошли.
+//
+// Запись переключается на новый сегмент до вызова list. list видит все
+// мутации до переключения (журнал пишется под блокировкой сегмента
+// хранилища до того, как версия становится видна) и, возможно, часть более
+// поздних: они повторно применятся из нового сегмента при восстановлении,
+// что безопасно, так как записи содержат полные версии наблюдений.
+func (l *Log) Snapshot(list func() []*ufo_v1.Sighting) error {
+	l.snapshotMu.Lock()
+	defer l.snapshotMu.Unlock()
+
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return ErrClosed
+	}
+	sequence := l.seq
+	if l.segment != sequence+1 {
+		if err := l.rotate(sequence + 1); err != nil {
+			l.mu.Unlock()
+			return err
+		}
+	}
+	l.mu.Unlock()
+
+	startTime := time.Now()
+	sightings := list()
+	if err := writeSnapshot(l.opts.Dir, sequence, sightings); err != nil {
+		return err
+	}
+
+	segments, err := listSegments(l.opts.Dir)
+	if err != nil {
+		return err
+	}
+	for _, start := range segments {
+		if start <= sequence {
+			if err := os.Remove(segmentPath(l.opts.Dir, start)); err != nil {
+				return fmt.Errorf("remove compacted wal segment: %w", err)
+			}
+		}
+	}
+
+	slog.Info("💾 Snapshot written", "count", len(sightings), "seq", sequence, "took", time.Since(startTime))
+	return nil
+}
+
+// Run периодически делает fsync (политика interval) и снимки
+// (SnapshotInterval > 0) до отмены ctx.
+func (l *Log) Run(ctx context.Context, list func() []*ufo_v1.Sighting) {
+	var syncC, snapshotC <-chan time.Time
+	if l.opts.Fsync == FsyncInterval && l.opts.FsyncInterval > 0 {
+		ticker := time.NewTicker(l.opts.FsyncInterval)
+		defer ticker.Stop()
+		syncC = ticker.C
+	}
+	if l.opts.SnapshotInterval > 0 {
+		ticker := time.NewTicker(l.opts.SnapshotInterval)
+		defer ticker.Stop()
+		snapshotC = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-syncC:
+			if err := l.Sync(); err != nil {
+				slog.Error("❌ WAL sync failed", "error", err)
+			}
+		case <-snapshotC:
+			if err := l.Snapshot(list); err != nil && !errors.Is(err, ErrClosed) {
+				slog.Error("❌ Snapshot failed", "error", err)
+			}
+		}
+	}
+}
+
+// Close сбрасывает журнал на диск и закрывает его.
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return nil
+	}
+	l.closed = true
+
+	if err := l.f.Sync(); err != nil {
+		l.f.Close()
+		return fmt.Errorf("sync wal: %w", err)
+	}
+	return l.f.Close()
+}
+
+// rotate закрывает текущий сегмент и открывает новый, начинающийся с start.
+// Вызывается под l.mu.
+func (l *Log) rotate(start uint64) error {
+	if err := l.f.Sync(); err != nil {
+		return fmt.Errorf("sync wal: %w", err)
+	}
+	if err := l.f.Close(); err != nil {
+		return fmt.Errorf("close wal segment: %w", err)
+	}
+	l.dirty = false
+	return l.openSegment(start)
+}
+
+func (l *Log) openSegment(start uint64) error {
+	f, err := os.OpenFile(segmentPath(l.opts.Dir, start), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("open wal segment: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("stat wal segment: %w", err)
+	}
+	if err := syncDir(l.opts.Dir); err != nil {
+		f.Close()
+		return fmt.Errorf("sync storage dir: %w", err)
+	}
+
+	l.f = f
+	l.offset = info.Size()
+	l.segment = start
+	return nil
+}
+
+// replaySegment применяет к state записи сегмента с sequence больше after.
+// Возвращает последний прочитанный sequence и количество примененных записей.
+// В последнем сегменте (tail) поврежденный хвост обрезается по последней целой
+// записи, в остальных повреждение — ошибка.
+func replaySegment(path string, after uint64, tail bool, state map[string]*ufo_v1.Sighting) (uint64, int, error) {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return 0, 0, fmt.Errorf("open wal segment: %w", err)
+	}
+	defer f.Close()
+
+	var (
+		r       = bufio.NewReader(f)
+		offset  int64
+		last    uint64
+		applied int
+	)
+	for {
+		payload, err := readFrame(r)
+		if errors.Is(err, io.EOF) {
+			return last, applied, nil
+		}
+
+		rec := &storage_v1.WALRecord{}
+		if err == nil {
+			if uerr := proto.Unmarshal(payload, rec); uerr != nil {
+				err = fmt.Errorf("%w: %v", errCorrupt, uerr)
+			}
+		}
+		if errors.Is(err, errCorrupt) {
+			if !tail {
+				return 0, 0, fmt.Errorf("wal segment %s corrupt at offset %d, later segments follow: %w", path, offset, err)
+			}
+			slog.Warn("⚠️ WAL corrupt tail truncated", "file", path, "offset", offset, "error", err)
+			if terr := f.Truncate(offset); terr != nil {
+				return 0, 0, fmt.Errorf("truncate wal segment: %w", terr)
+			}
+			if serr := f.Sync(); serr != nil {
+				return 0, 0, fmt.Errorf("sync wal segment: %w", serr)
+			}
+			return last, applied, nil
+		}
+		if err != nil {
+			return 0, 0, fmt.Errorf("read wal segment %s: %w", path, err)
+		}
+
+		offset += int64(frameHeaderSize + len(payload))
+		last = rec.GetSequence()
+		if rec.GetSequence() <= after {
+			continue
+		}
+
+		switch m := rec.GetMutation().(type) {
+		case *storage_v1.WALRecord_Create:
+			state[m.Create.GetUuid()] = m.Create
+		case *storage_v1.WALRecord_Update:
+			state[m.Update.GetUuid()] = m.Update
+		case *storage_v1.WALRecord_Delete:
+			delete(state, m.Delete)
+		}
+		applied++
+	}
+}
+
+func segmentPath(dir string, start uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("wal-%020d.log", start))
+}
+
+// listSegments возвращает начальные sequence сегментов по возрастанию.
+func listSegments(dir string) ([]uint64, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "wal-*.log"))
+	if err != nil {
+		return nil, err
+	}
+
+	starts := make([]uint64, 0, len(paths))
+	for _, p := range paths {
+		var start uint64
+		if _, err := fmt.Sscanf(filepath.Base(p), "wal-%d.log", &start); err != nil {
+			return nil, fmt.Errorf("unexpected wal segment name %s", p)
+		}
+		starts = append(starts, start)
+	}
+	slices.Sort(starts)
+	return starts, nil
+}
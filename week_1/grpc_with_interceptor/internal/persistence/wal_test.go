@@ -0,0 +1,470 @@
+package persistence
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+)
+
+func newSighting(id, location string) *ufo_v1.Sighting {
+	return &ufo_v1.Sighting{Uuid: id, Info: &ufo_v1.SightingInfo{Location: location}}
+}
+
+func openLog(t *testing.T, dir string) (*Log, map[string]*ufo_v1.Sighting) {
+	t.Helper()
+
+	l, sightings, err := Open(Options{Dir: dir, Fsync: FsyncNever})
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	t.Cleanup(func() { _ = l.Close() })
+
+	state := make(map[string]*ufo_v1.Sighting, len(sightings))
+	for _, s := range sightings {
+		state[s.GetUuid()] = s
+	}
+	return l, state
+}
+
+func mustAppend(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("append: %v", err)
+	}
+}
+
+func locations(state map[string]*ufo_v1.Sighting) map[string]string {
+	out := make(map[string]string, len(state))
+	for id, s := range state {
+		out[id] = s.GetInfo().GetLocation()
+	}
+	return out
+}
+
+func assertState(t *testing.T, state map[string]*ufo_v1.Sighting, want map[string]string) {
+	t.Helper()
+
+	got := locations(state)
+	if len(got) != len(want) {
+		t.Fatalf("restored %v, want %v", got, want)
+	}
+	for id, loc := range want {
+		if got[id] != loc {
+			t.Fatalf("restored %v, want %v", got, want)
+		}
+	}
+}
+
+func segmentFiles(t *testing.T, dir string) []string {
+	t.Helper()
+
+	starts, err := listSegments(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	paths := make([]string, 0, len(starts))
+	for _, start := range starts {
+		paths = append(paths, segmentPath(dir, start))
+	}
+	return paths
+}
+
+func TestOpenReplaysMutations(t *testing.T) {
+	dir := t.TempDir()
+
+	l, _ := openLog(t, dir)
+	mustAppend(t, l.Created(newSighting("a", "Roswell")))
+	mustAppend(t, l.Created(newSighting("b", "Area 51")))
+	mustAppend(t, l.Updated(newSighting("a", "Phoenix")))
+	mustAppend(t, l.Deleted("b"))
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	l, state := openLog(t, dir)
+	assertState(t, state, map[string]string{"a": "Phoenix"})
+
+	// Sequence продолжается после восстановления.
+	mustAppend(t, l.Created(newSighting("c", "Rendlesham")))
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, state = openLog(t, dir)
+	assertState(t, state, map[string]string{"a": "Phoenix", "c": "Rendlesham"})
+}
+
+func TestOpenTruncatesCorruptTail(t *testing.T) {
+	tests := []struct {
+		name    string
+		corrupt func(t *testing.T, path string)
+		want    map[string]string
+	}{
+		{
+			name: "truncated record",
+			corrupt: func(t *testing.T, path string) {
+				data, err := os.ReadFile(path)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, data[:len(data)-3], 0o644); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: map[string]string{"a": "Roswell"},
+		},
+		{
+			name: "checksum mismatch",
+			corrupt: func(t *testing.T, path string) {
+				data, err := os.ReadFile(path)
+				if err != nil {
+					t.Fatal(err)
+				}
+				data[len(data)-1] ^= 0xff
+				if err := os.WriteFile(path, data, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: map[string]string{"a": "Roswell"},
+		},
+		{
+			name: "partial header",
+			corrupt: func(t *testing.T, path string) {
+				f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer f.Close()
+				if _, err := f.Write([]byte{1, 2, 3}); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: map[string]string{"a": "Roswell", "b": "Area 51"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			l, _ := openLog(t, dir)
+			mustAppend(t, l.Created(newSighting("a", "Roswell")))
+			mustAppend(t, l.Created(newSighting("b", "Area 51")))
+			if err := l.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			tt.corrupt(t, segmentFiles(t, dir)[0])
+
+			l, state := openLog(t, dir)
+			assertState(t, state, tt.want)
+
+			// Хвост обрезан по последней целой записи: новые записи читаются.
+			mustAppend(t, l.Created(newSighting("c", "Rendlesham")))
+			if err := l.Close(); err != nil {
+				t.Fatal(err)
+			}
+			_, state = openLog(t, dir)
+			tt.want["c"] = "Rendlesham"
+			assertState(t, state, tt.want)
+		})
+	}
+}
+
+func TestOpenFailsOnCorruptionBeforeLastSegment(t *testing.T) {
+	dir := t.TempDir()
+
+	// Каждый Open начинает новый сегмент.
+	l, _ := openLog(t, dir)
+	mustAppend(t, l.Created(newSighting("a", "Roswell")))
+	mustAppend(t, l.Created(newSighting("b", "Area 51")))
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+	l, _ = openLog(t, dir)
+	mustAppend(t, l.Updated(newSighting("b", "Phoenix")))
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	segments := segmentFiles(t, dir)
+	if len(segments) < 2 {
+		t.Fatalf("segments = %v, want at least 2", segments)
+	}
+	data, err := os.ReadFile(segments[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	data[frameHeaderSize] ^= 0xff
+	if err := os.WriteFile(segments[0], data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := Open(Options{Dir: dir, Fsync: FsyncNever}); !errors.Is(err, errCorrupt) {
+		t.Fatalf("Open error = %v, want errCorrupt", err)
+	}
+
+	// Поврежденный сегмент не обрезан: данные остаются для ручного разбора.
+	after, err := os.ReadFile(segments[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(after) != len(data) {
+		t.Fatalf("segment size = %d, want %d (untouched)", len(after), len(data))
+	}
+}
+
+func TestSnapshotCompactsSegments(t *testing.T) {
+	dir := t.TempDir()
+
+	l, _ := openLog(t, dir)
+	state := map[string]*ufo_v1.Sighting{}
+	for _, s := range []*ufo_v1.Sighting{newSighting("a", "Roswell"), newSighting("b", "Area 51")} {
+		mustAppend(t, l.Created(s))
+		state[s.GetUuid()] = s
+	}
+
+	list := func() []*ufo_v1.Sighting {
+		out := make([]*ufo_v1.Sighting, 0, len(state))
+		for _, s := range state {
+			out = append(out, s)
+		}
+		return out
+	}
+	if err := l.Snapshot(list); err != nil {
+		t.Fatalf("Snapshot: %v", err)
+	}
+
+	segments, err := listSegments(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(segments) != 1 || segments[0] != 3 {
+		t.Fatalf("segments after snapshot = %v, want only [3]", segments)
+	}
+
+	mustAppend(t, l.Deleted("a"))
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, restored := openLog(t, dir)
+	assertState(t, restored, map[string]string{"b": "Area 51"})
+}
+
+func TestSnapshotSkipsReplayedRecords(t *testing.T) {
+	dir := t.TempDir()
+
+	l, _ := openLog(t, dir)
+	mustAppend(t, l.Created(newSighting("a", "Roswell")))
+
+	// Снимок видит более позднее состояние, чем записи в сегменте: записи с
+	// sequence не больше снимка не применяются повторно.
+	if err := writeSnapshot(dir, 1, []*ufo_v1.Sighting{newSighting("a", "Phoenix")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, state := openLog(t, dir)
+	assertState(t, state, map[string]string{"a": "Phoenix"})
+}
+
+func TestFrameUsesCRC32C(t *testing.T) {
+	payload := []byte("sighting")
+	frame := appendFrame(nil, payload)
+
+	sum := binary.LittleEndian.Uint32(frame[4:8])
+	if want := crc32.Checksum(payload, crc32.MakeTable(crc32.Castagnoli)); sum != want {
+		t.Fatalf("frame checksum = %#x, want CRC-32C %#x", sum, want)
+	}
+	if sum == crc32.ChecksumIEEE(payload) {
+		t.Fatal("frame checksum matches IEEE polynomial, want Castagnoli")
+	}
+}
+
+func TestFsyncPolicies(t *testing.T) {
+	tests := []struct {
+		policy    string
+		wantDirty bool
+	}{
+		{policy: FsyncAlways, wantDirty: false},
+		{policy: FsyncInterval, wantDirty: true},
+		{policy: FsyncNever, wantDirty: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.policy, func(t *testing.T) {
+			l, _, err := Open(Options{Dir: t.TempDir(), Fsync: tt.policy, FsyncInterval: time.Hour})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer l.Close()
+
+			mustAppend(t, l.Created(newSighting("a", "Roswell")))
+			if l.dirty != tt.wantDirty {
+				t.Fatalf("dirty after append = %v, want %v", l.dirty, tt.wantDirty)
+			}
+
+			if err := l.Sync(); err != nil {
+				t.Fatal(err)
+			}
+			if l.dirty {
+				t.Fatal("dirty after Sync, want false")
+			}
+		})
+	}
+}
+
+func TestRunSyncsOnInterval(t *testing.T) {
+	l, _, err := Open(Options{Dir: t.TempDir(), Fsync: FsyncInterval, FsyncInterval: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		l.Run(ctx, func() []*ufo_v1.Sighting { return nil })
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	mustAppend(t, l.Created(newSighting("a", "Roswell")))
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		l.mu.Lock()
+		dirty := l.dirty
+		l.mu.Unlock()
+		if !dirty {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("interval fsync did not run")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestAppendAfterCloseFails(t *testing.T) {
+	l, _ := openLog(t, t.TempDir())
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Created(newSighting("a", "Roswell")); !errors.Is(err, ErrClosed) {
+		t.Fatalf("Created after Close = %v, want ErrClosed", err)
+	}
+}
+
+// faultyFile сегмент журнала, в котором можно сломать запись, fsync и обрезку.
+type faultyFile struct {
+	segmentFile
+
+	partialWrite bool
+	syncErr      error
+	truncateErr  error
+}
+
+func (f *faultyFile) Write(b []byte) (int, error) {
+	if f.partialWrite {
+		n, _ := f.segmentFile.Write(b[:len(b)/2])
+		return n, errors.New("no space left on device")
+	}
+	return f.segmentFile.Write(b)
+}
+
+func (f *faultyFile) Sync() error {
+	if f.syncErr != nil {
+		return f.syncErr
+	}
+	return f.segmentFile.Sync()
+}
+
+func (f *faultyFile) Truncate(size int64) error {
+	if f.truncateErr != nil {
+		return f.truncateErr
+	}
+	return f.segmentFile.Truncate(size)
+}
+
+func TestAppendRollsBackFailedRecord(t *testing.T) {
+	errIO := errors.New("input/output error")
+
+	tests := []struct {
+		name  string
+		fault faultyFile
+	}{
+		{name: "fsync fails", fault: faultyFile{syncErr: errIO}},
+		{name: "partial write", fault: faultyFile{partialWrite: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			l, _, err := Open(Options{Dir: dir, Fsync: FsyncAlways})
+			if err != nil {
+				t.Fatal(err)
+			}
+			mustAppend(t, l.Created(newSighting("a", "Roswell")))
+
+			fault := tt.fault
+			fault.segmentFile = l.f
+			l.f = &fault
+			if err := l.Created(newSighting("b", "Area 51")); err == nil {
+				t.Fatal("Created with a failing disk = nil, want error")
+			}
+			if l.seq != 1 {
+				t.Fatalf("seq after failed append = %d, want 1", l.seq)
+			}
+
+			// Диск ожил: следующая запись получает sequence неудавшейся.
+			fault.partialWrite, fault.syncErr = false, nil
+			mustAppend(t, l.Updated(newSighting("a", "Phoenix")))
+			if l.seq != 2 {
+				t.Fatalf("seq after recovery = %d, want 2", l.seq)
+			}
+			if err := l.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			_, state := openLog(t, dir)
+			assertState(t, state, map[string]string{"a": "Phoenix"})
+		})
+	}
+}
+
+func TestAppendFailsAfterFailedRollback(t *testing.T) {
+	l, _, err := Open(Options{Dir: t.TempDir(), Fsync: FsyncAlways})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	errIO := errors.New("input/output error")
+	fault := &faultyFile{segmentFile: l.f, syncErr: errIO, truncateErr: errIO}
+	l.f = fault
+
+	if err := l.Created(newSighting("a", "Roswell")); !errors.Is(err, errIO) {
+		t.Fatalf("Created = %v, want sync error", err)
+	}
+
+	// Хвост сегмента неизвестен: журнал не принимает записи до перезапуска.
+	fault.syncErr, fault.truncateErr = nil, nil
+	err = l.Created(newSighting("b", "Area 51"))
+	if err == nil || !strings.Contains(err.Error(), "unusable after failed rollback") {
+		t.Fatalf("Created after failed rollback = %v, want unusable wal error", err)
+	}
+}
@@ -0,0 +1,162 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/apperr"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/store"
+)
+
+// metrics счетчики задачи, доступные в /debug/vars служебного сервера.
+var metrics = expvar.NewMap("retention")
+
+// Options настройки очистки удаленных наблюдений.
+type Options struct {
+	// Retention сколько хранить наблюдение после мягкого удаления.
+	Retention time.Duration
+	// Interval период запуска очистки.
+	Interval time.Duration
+	// DryRun только считает и логирует кандидатов, ничего не удаляя.
+	DryRun bool
+}
+
+// Result итог одного прохода очистки.
+type Result struct {
+	Scanned  int
+	Expired  int
+	Purged   int
+	Duration time.Duration
+}
+
+// Job периодически окончательно удаляет наблюдения, у которых deleted_at
+// старше Retention.
+type Job struct {
+	store *store.Store
+	opts  Options
+	now   func() time.Time
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	once   sync.Once
+}
+
+// New создает задачу очистки хранилища. Запуск выполняет Start.
+func New(sightings *store.Store, opts Options) *Job {
+	return &Job{
+		store: sightings,
+		opts:  opts,
+		now:   time.Now,
+		done:  make(chan struct{}),
+	}
+}
+
+// Start выполняет первый проход сразу (например, после восстановления из WAL),
+// затем повторяет его каждые Interval до вызова Shutdown.
+func (j *Job) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	j.cancel = cancel
+
+	go func() {
+		defer close(j.done)
+
+		ticker := time.NewTicker(j.opts.Interval)
+		defer ticker.Stop()
+
+		for {
+			if _, err := j.RunOnce(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				slog.Error("❌ Retention failed", "error", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Shutdown прерывает текущий проход между удалениями и ждет завершения задачи.
+func (j *Job) Shutdown(ctx context.Context) error {
+	j.once.Do(func() {
+		if j.cancel != nil {
+			j.cancel()
+		} else {
+			close(j.done)
+		}
+	})
+
+	select {
+	case <-j.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RunOnce выполняет один проход очистки. Отмена ctx останавливает проход
+// между удалениями; уже удаленные наблюдения учитываются в результате.
+func (j *Job) RunOnce(ctx context.Context) (Result, error) {
+	start := j.now()
+	cutoff := start.Add(-j.opts.Retention)
+
+	var res Result
+	var err error
+	for _, s := range j.store.List() {
+		res.Scanned++
+
+		deletedAt := s.GetDeletedAt()
+		if deletedAt == nil || !deletedAt.AsTime().Before(cutoff) {
+			continue
+		}
+		res.Expired++
+		if j.opts.DryRun {
+			continue
+		}
+
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		// Удаленные наблюдения больше не меняются, поэтому между List и Delete
+		// deleted_at остается прежним; NotFound значит, что наблюдение уже удалено.
+		if delErr := j.store.Delete(s.GetUuid()); delErr != nil {
+			if errors.Is(delErr, apperr.ErrNotFound) {
+				continue
+			}
+			err = delErr
+			break
+		}
+		res.Purged++
+	}
+	res.Duration = j.now().Sub(start)
+
+	j.report(res, err)
+	return res, err
+}
+
+func (j *Job) report(res Result, err error) {
+	metrics.Add("runs", 1)
+	metrics.Add("scanned", int64(res.Scanned))
+	metrics.Add("expired", int64(res.Expired))
+	metrics.Add("purged", int64(res.Purged))
+	if err != nil {
+		metrics.Add("errors", 1)
+	}
+	last := new(expvar.Int)
+	last.Set(j.now().Unix())
+	metrics.Set("last_run_unix", last)
+
+	switch {
+	case j.opts.DryRun:
+		slog.Info("🧹 Retention dry-run", "would_purge", res.Expired, "scanned", res.Scanned,
+			"retention", j.opts.Retention, "took", res.Duration)
+	case res.Expired > 0 || err != nil:
+		slog.Info("🧹 Retention purged expired sightings", "purged", res.Purged, "expired", res.Expired,
+			"scanned", res.Scanned, "took", res.Duration)
+	}
+}
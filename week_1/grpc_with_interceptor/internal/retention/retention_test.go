@@ -0,0 +1,226 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"testing"
+	"time"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/store"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	testNow       = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	testRetention = 30 * 24 * time.Hour
+	testCutoff    = testNow.Add(-testRetention)
+)
+
+// journal реализует store.Journal; deleted вызывается на каждом удалении.
+type journal struct {
+	deleted func(uuid string) error
+}
+
+func (journal) Created(*ufo_v1.Sighting) error { return nil }
+func (journal) Updated(*ufo_v1.Sighting) error { return nil }
+func (j journal) Deleted(uuid string) error    { return j.deleted(uuid) }
+
+func sighting(uuid string, deletedAt time.Time) *ufo_v1.Sighting {
+	s := &ufo_v1.Sighting{Uuid: uuid, Info: &ufo_v1.SightingInfo{Location: "Roswell"}}
+	if !deletedAt.IsZero() {
+		s.DeletedAt = timestamppb.New(deletedAt)
+	}
+	return s
+}
+
+func newJob(sightings *store.Store, dryRun bool) *Job {
+	j := New(sightings, Options{Retention: testRetention, Interval: time.Hour, DryRun: dryRun})
+	j.now = func() time.Time { return testNow }
+	return j
+}
+
+func counter(name string) int64 {
+	v, ok := metrics.Get(name).(*expvar.Int)
+	if !ok {
+		return 0
+	}
+	return v.Value()
+}
+
+func TestRunOnceCutoffBoundary(t *testing.T) {
+	// Граница строгая: удаляется только то, что удалено раньше cutoff.
+	tests := []struct {
+		name       string
+		deletedAt  time.Time
+		wantPurged bool
+	}{
+		{name: "not deleted", deletedAt: time.Time{}},
+		{name: "deleted recently", deletedAt: testNow.Add(-time.Hour)},
+		{name: "exactly at cutoff", deletedAt: testCutoff},
+		{name: "just before cutoff", deletedAt: testCutoff.Add(-time.Second), wantPurged: true},
+		{name: "long ago", deletedAt: testCutoff.Add(-365 * 24 * time.Hour), wantPurged: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := store.New(4)
+			s.Restore([]*ufo_v1.Sighting{sighting("a", tt.deletedAt)})
+
+			res, err := newJob(s, false).RunOnce(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, kept := s.Get("a")
+			if kept == tt.wantPurged {
+				t.Errorf("kept = %v, want purged = %v", kept, tt.wantPurged)
+			}
+			want := 0
+			if tt.wantPurged {
+				want = 1
+			}
+			if res.Scanned != 1 || res.Expired != want || res.Purged != want {
+				t.Errorf("result = %+v, want scanned 1, expired and purged %d", res, want)
+			}
+		})
+	}
+}
+
+func TestRunOnceDryRunVsPurge(t *testing.T) {
+	old := testCutoff.Add(-time.Hour)
+	fill := func() *store.Store {
+		s := store.New(4)
+		s.Restore([]*ufo_v1.Sighting{
+			sighting("old-1", old),
+			sighting("old-2", old),
+			sighting("recent", testNow.Add(-time.Hour)),
+			sighting("alive", time.Time{}),
+		})
+		return s
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		s := fill()
+		res, err := newJob(s, true).RunOnce(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Scanned != 4 || res.Expired != 2 || res.Purged != 0 {
+			t.Errorf("result = %+v, want scanned 4, expired 2, purged 0", res)
+		}
+		if s.Len() != 4 {
+			t.Errorf("store has %d sightings after dry run, want 4", s.Len())
+		}
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		s := fill()
+		res, err := newJob(s, false).RunOnce(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Scanned != 4 || res.Expired != 2 || res.Purged != 2 {
+			t.Errorf("result = %+v, want scanned 4, expired 2, purged 2", res)
+		}
+		for _, id := range []string{"old-1", "old-2"} {
+			if _, ok := s.Get(id); ok {
+				t.Errorf("%s still stored", id)
+			}
+		}
+		if s.Len() != 2 {
+			t.Errorf("store has %d sightings, want 2", s.Len())
+		}
+	})
+}
+
+func TestRunOnceUpdatesCounters(t *testing.T) {
+	old := testCutoff.Add(-time.Hour)
+	s := store.New(4, store.WithJournal(journal{deleted: func(uuid string) error {
+		if uuid == "broken" {
+			return errors.New("disk full")
+		}
+		return nil
+	}}))
+	s.Restore([]*ufo_v1.Sighting{sighting("old", old), sighting("alive", time.Time{})})
+
+	before := map[string]int64{}
+	for _, name := range []string{"runs", "scanned", "expired", "purged", "errors"} {
+		before[name] = counter(name)
+	}
+	delta := func(name string) int64 { return counter(name) - before[name] }
+
+	if _, err := newJob(s, false).RunOnce(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if delta("runs") != 1 || delta("scanned") != 2 || delta("expired") != 1 || delta("purged") != 1 || delta("errors") != 0 {
+		t.Errorf("counters after purge: runs %d, scanned %d, expired %d, purged %d, errors %d; want 1, 2, 1, 1, 0",
+			delta("runs"), delta("scanned"), delta("expired"), delta("purged"), delta("errors"))
+	}
+	if got := counter("last_run_unix"); got != testNow.Unix() {
+		t.Errorf("last_run_unix = %d, want %d", got, testNow.Unix())
+	}
+
+	s.Restore([]*ufo_v1.Sighting{sighting("broken", old)})
+	if _, err := newJob(s, false).RunOnce(context.Background()); err == nil {
+		t.Fatal("RunOnce error = nil, want journal error")
+	}
+	if delta("runs") != 2 || delta("errors") != 1 || delta("purged") != 1 {
+		t.Errorf("counters after failure: runs %d, errors %d, purged %d; want 2, 1, 1",
+			delta("runs"), delta("errors"), delta("purged"))
+	}
+}
+
+func TestShutdownStopsRunBetweenDeletes(t *testing.T) {
+	entered := make(chan string, 3)
+	release := make(chan struct{})
+	s := store.New(4, store.WithJournal(journal{deleted: func(uuid string) error {
+		entered <- uuid
+		<-release
+		return nil
+	}}))
+	old := testCutoff.Add(-time.Hour)
+	s.Restore([]*ufo_v1.Sighting{sighting("a", old), sighting("b", old), sighting("c", old)})
+
+	j := newJob(s, false)
+	j.Start()
+
+	// Первый проход стартует сразу и останавливается на первом удалении.
+	select {
+	case <-entered:
+	case <-time.After(5 * time.Second):
+		t.Fatal("retention did not start")
+	}
+
+	// Shutdown отменяет проход и ждет текущее удаление дольше своего ctx.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := j.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Shutdown = %v, want deadline exceeded while a delete is in flight", err)
+	}
+	close(release)
+
+	// Следующие удаления после отмены не начинаются.
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := j.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+	if s.Len() != 2 {
+		t.Errorf("store has %d sightings, want 2: only the in-flight delete completes", s.Len())
+	}
+}
+
+func TestShutdownWithoutStart(t *testing.T) {
+	j := newJob(store.New(4), false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := j.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+	if err := j.Shutdown(ctx); err != nil {
+		t.Fatalf("second Shutdown: %v", err)
+	}
+}
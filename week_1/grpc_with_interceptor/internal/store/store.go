@@ -0,0 +1,209 @@
+package store
+
+import (
+	"errors"
+	"hash/maphash"
+	"sync"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/apperr"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultShards количество сегментов хранилища по умолчанию.
+const DefaultShards = 32
+
+// Store потокобезопасное хранилище наблюдений в памяти с блокировками по
+// сегментам (lock striping): uuid хешируется в один из сегментов, у каждого
+// свой map и RWMutex. Запись блокирует только свой сегмент, а List обходит
+// сегменты по очереди, поэтому большие выборки не останавливают запись в
+// остальные сегменты.
+//
+// Сохраненные наблюдения неизменяемы: Create сохраняет копию, Update меняет
+// копию и подменяет ею прежнюю версию (clone-on-write). Поэтому Get и List
+// отдают согласованные снимки, которые можно сериализовать без блокировок,
+// пока параллельно идут обновления. Вызывающий код не должен изменять
+// полученные из Store наблюдения.
+type Store struct {
+	seed    maphash.Seed
+	shards  []shard
+	journal Journal
+}
+
+// Journal получает каждую мутацию до того, как она станет видна читателям
+// (журнал упреждающей записи). Если журнал вернул ошибку, мутация не
+// применяется. Вызовы для одного uuid идут строго по порядку под блокировкой
+// его сегмента.
+type Journal interface {
+	Created(sighting *ufo_v1.Sighting) error
+	Updated(sighting *ufo_v1.Sighting) error
+	Deleted(uuid string) error
+}
+
+// Option настраивает Store.
+type Option func(*Store)
+
+// WithJournal включает запись мутаций в journal.
+func WithJournal(journal Journal) Option {
+	return func(s *Store) {
+		s.journal = journal
+	}
+}
+
+type shard struct {
+	mu    sync.RWMutex
+	items map[string]*ufo_v1.Sighting
+}
+
+// New создает хранилище из shards сегментов. shards = 1 соответствует
+// одному map под общим мьютексом.
+func New(shards int, opts ...Option) *Store {
+	if shards < 1 {
+		shards = DefaultShards
+	}
+
+	s := &Store{
+		seed:   maphash.MakeSeed(),
+		shards: make([]shard, shards),
+	}
+	for i := range s.shards {
+		s.shards[i].items = make(map[string]*ufo_v1.Sighting)
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *Store) shard(uuid string) *shard {
+	return &s.shards[maphash.String(s.seed, uuid)%uint64(len(s.shards))]
+}
+
+// Ping проверяет, что хранилище инициализировано.
+func (s *Store) Ping() error {
+	if s == nil || len(s.shards) == 0 {
+		return errors.New("sighting store is not initialized")
+	}
+	return nil
+}
+
+// Create сохраняет копию нового наблюдения, поэтому sighting можно менять и
+// после вызова. Если uuid уже занят, возвращает apperr.Conflict.
+func (s *Store) Create(sighting *ufo_v1.Sighting) error {
+	stored := proto.CloneOf(sighting)
+	sh := s.shard(stored.GetUuid())
+
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	if _, ok := sh.items[stored.GetUuid()]; ok {
+		return apperr.Conflict(stored.GetUuid(), "uuid already exists")
+	}
+	if s.journal != nil {
+		if err := s.journal.Created(stored); err != nil {
+			return err
+		}
+	}
+	sh.items[stored.GetUuid()] = stored
+	return nil
+}
+
+// Restore загружает наблюдения, восстановленные при старте, без записи в
+// журнал. Существующие версии с тем же uuid заменяются.
+func (s *Store) Restore(sightings []*ufo_v1.Sighting) {
+	for _, sighting := range sightings {
+		sh := s.shard(sighting.GetUuid())
+
+		sh.mu.Lock()
+		sh.items[sighting.GetUuid()] = sighting
+		sh.mu.Unlock()
+	}
+}
+
+// Get возвращает неизменяемый снимок наблюдения по uuid.
+func (s *Store) Get(uuid string) (*ufo_v1.Sighting, bool) {
+	sh := s.shard(uuid)
+
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+
+	sighting, ok := sh.items[uuid]
+	return sighting, ok
+}
+
+// Update вызывает fn с копией наблюдения uuid под блокировкой его сегмента
+// и, если fn вернула nil, сохраняет копию как новую версию. Снимки, полученные
+// раньше, не меняются. Если наблюдения нет, возвращает apperr.NotFound; ошибка
+// fn возвращается как есть, а хранилище остается без изменений.
+func (s *Store) Update(uuid string, fn func(sighting *ufo_v1.Sighting) error) error {
+	sh := s.shard(uuid)
+
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	current, ok := sh.items[uuid]
+	if !ok {
+		return apperr.NotFound(uuid)
+	}
+
+	next := proto.CloneOf(current)
+	if err := fn(next); err != nil {
+		return err
+	}
+	if s.journal != nil {
+		if err := s.journal.Updated(next); err != nil {
+			return err
+		}
+	}
+	sh.items[uuid] = next
+	return nil
+}
+
+// Delete окончательно удаляет наблюдение uuid. Если наблюдения нет,
+// возвращает apperr.NotFound.
+func (s *Store) Delete(uuid string) error {
+	sh := s.shard(uuid)
+
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	if _, ok := sh.items[uuid]; !ok {
+		return apperr.NotFound(uuid)
+	}
+	if s.journal != nil {
+		if err := s.journal.Deleted(uuid); err != nil {
+			return err
+		}
+	}
+	delete(sh.items, uuid)
+	return nil
+}
+
+// List возвращает неизменяемые снимки всех наблюдений. Сегменты блокируются по одному, поэтому
+// результат не является атомарным снимком всего хранилища.
+func (s *Store) List() []*ufo_v1.Sighting {
+	result := make([]*ufo_v1.Sighting, 0, s.Len())
+	for i := range s.shards {
+		sh := &s.shards[i]
+
+		sh.mu.RLock()
+		for _, sighting := range sh.items {
+			result = append(result, sighting)
+		}
+		sh.mu.RUnlock()
+	}
+	return result
+}
+
+// Len возвращает количество наблюдений.
+func (s *Store) Len() int {
+	n := 0
+	for i := range s.shards {
+		sh := &s.shards[i]
+
+		sh.mu.RLock()
+		n += len(sh.items)
+		sh.mu.RUnlock()
+	}
+	return n
+}
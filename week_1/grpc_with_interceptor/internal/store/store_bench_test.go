@@ -0,0 +1,162 @@
+package store
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/apperr"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// sightingStore общий интерфейс Store и эталонного mutexStore для бенчмарков.
+type sightingStore interface {
+	Create(sighting *ufo_v1.Sighting) error
+	Get(uuid string) (*ufo_v1.Sighting, bool)
+	Update(uuid string, fn func(sighting *ufo_v1.Sighting) error) error
+	List() []*ufo_v1.Sighting
+}
+
+// mutexStore прежняя схема хранения: один map под одним RWMutex.
+type mutexStore struct {
+	mu    sync.RWMutex
+	items map[string]*ufo_v1.Sighting
+}
+
+func (s *mutexStore) Create(sighting *ufo_v1.Sighting) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.items[sighting.GetUuid()] = sighting
+	return nil
+}
+
+func (s *mutexStore) Get(uuid string) (*ufo_v1.Sighting, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	sighting, ok := s.items[uuid]
+	return sighting, ok
+}
+
+func (s *mutexStore) Update(uuid string, fn func(sighting *ufo_v1.Sighting) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sighting, ok := s.items[uuid]
+	if !ok {
+		return apperr.NotFound(uuid)
+	}
+	return fn(sighting)
+}
+
+func (s *mutexStore) List() []*ufo_v1.Sighting {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	result := make([]*ufo_v1.Sighting, 0, len(s.items))
+	for _, sighting := range s.items {
+		result = append(result, sighting)
+	}
+	return result
+}
+
+// BenchmarkStoreMixed сравнивает пропускную способность прежней схемы с одним
+// мьютексом и хранилища с сегментами при смешанной нагрузке: 60% Get,
+// 30% Update и 10% Create на хранилище из 10 000 наблюдений.
+func BenchmarkStoreMixed(b *testing.B) {
+	stores := []struct {
+		name string
+		new  func() sightingStore
+	}{
+		{"mutex", func() sightingStore { return &mutexStore{items: make(map[string]*ufo_v1.Sighting)} }},
+		{"sharded-1", func() sightingStore { return New(1) }},
+		{"sharded-32", func() sightingStore { return New(32) }},
+		{"sharded-128", func() sightingStore { return New(128) }},
+	}
+
+	for _, tc := range stores {
+		b.Run(tc.name, func(b *testing.B) {
+			s := tc.new()
+			ids := make([]string, 10_000)
+			for i := range ids {
+				ids[i] = uuid.NewString()
+				if err := s.Create(newSighting(ids[i])); err != nil {
+					b.Fatal(err)
+				}
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					id := ids[rand.IntN(len(ids))]
+					switch n := rand.IntN(100); {
+					case n < 60:
+						s.Get(id)
+					case n < 90:
+						_ = s.Update(id, func(sighting *ufo_v1.Sighting) error {
+							sighting.UpdatedAt = timestamppb.Now()
+							return nil
+						})
+					default:
+						_ = s.Create(newSighting(uuid.NewString()))
+					}
+				}
+			})
+		})
+	}
+}
+
+// BenchmarkStoreUpdateDuringList измеряет Update, пока другие горутины
+// постоянно выполняют List: с одним сегментом запись ждет окончания выборки.
+func BenchmarkStoreUpdateDuringList(b *testing.B) {
+	for _, shards := range []int{1, 32} {
+		b.Run(fmt.Sprintf("shards-%d", shards), func(b *testing.B) {
+			s := New(shards)
+			ids := make([]string, 10_000)
+			for i := range ids {
+				ids[i] = uuid.NewString()
+				_ = s.Create(newSighting(ids[i]))
+			}
+
+			done := make(chan struct{})
+			var wg sync.WaitGroup
+			for range 2 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for {
+						select {
+						case <-done:
+							return
+						default:
+							s.List()
+						}
+					}
+				}()
+			}
+
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					_ = s.Update(ids[rand.IntN(len(ids))], func(sighting *ufo_v1.Sighting) error {
+						sighting.UpdatedAt = timestamppb.Now()
+						return nil
+					})
+				}
+			})
+			b.StopTimer()
+
+			close(done)
+			wg.Wait()
+		})
+	}
+}
+
+func newSighting(id string) *ufo_v1.Sighting {
+	return &ufo_v1.Sighting{
+		Uuid:      id,
+		Info:      &ufo_v1.SightingInfo{Location: "Roswell"},
+		CreatedAt: timestamppb.Now(),
+	}
+}
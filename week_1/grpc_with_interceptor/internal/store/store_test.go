@@ -0,0 +1,173 @@
+package store
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/apperr"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestCreateDoesNotAliasCallerMessage(t *testing.T) {
+	s := New(4)
+	info := &ufo_v1.SightingInfo{Location: "Roswell"}
+	id := uuid.NewString()
+
+	if err := s.Create(&ufo_v1.Sighting{Uuid: id, Info: info}); err != nil {
+		t.Fatal(err)
+	}
+	info.Location = "changed by caller"
+
+	got, _ := s.Get(id)
+	if got.GetInfo().GetLocation() != "Roswell" {
+		t.Fatalf("stored location = %q, want %q", got.GetInfo().GetLocation(), "Roswell")
+	}
+}
+
+func TestCreateDuplicateUUIDIsConflict(t *testing.T) {
+	s := New(4)
+	id := uuid.NewString()
+	if err := s.Create(newSighting(id)); err != nil {
+		t.Fatal(err)
+	}
+
+	err := s.Create(&ufo_v1.Sighting{Uuid: id, Info: &ufo_v1.SightingInfo{Location: "Area 51"}})
+	if !errors.Is(err, apperr.ErrConflict) {
+		t.Fatalf("Create error = %v, want apperr.ErrConflict", err)
+	}
+	got, _ := s.Get(id)
+	if got.GetInfo().GetLocation() != "Roswell" {
+		t.Fatalf("duplicate create replaced stored sighting: %v", got)
+	}
+}
+
+func TestUpdateDoesNotChangeReturnedSnapshot(t *testing.T) {
+	s := New(4)
+	id := uuid.NewString()
+	if err := s.Create(newSighting(id)); err != nil {
+		t.Fatal(err)
+	}
+
+	before, _ := s.Get(id)
+	err := s.Update(id, func(sighting *ufo_v1.Sighting) error {
+		sighting.Info.Location = "Area 51"
+		sighting.Info.Color = wrapperspb.String("green")
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if before.GetInfo().GetLocation() != "Roswell" || before.GetInfo().GetColor() != nil {
+		t.Fatalf("snapshot taken before update was modified: %v", before)
+	}
+	after, _ := s.Get(id)
+	if after.GetInfo().GetLocation() != "Area 51" || after.GetInfo().GetColor().GetValue() != "green" {
+		t.Fatalf("update was not applied: %v", after)
+	}
+}
+
+func TestUpdateErrorKeepsPreviousVersion(t *testing.T) {
+	s := New(4)
+	id := uuid.NewString()
+	if err := s.Create(newSighting(id)); err != nil {
+		t.Fatal(err)
+	}
+
+	errStop := errors.New("stop")
+	err := s.Update(id, func(sighting *ufo_v1.Sighting) error {
+		sighting.Info.Location = "half-applied"
+		return errStop
+	})
+	if err != errStop {
+		t.Fatalf("Update error = %v, want %v", err, errStop)
+	}
+
+	got, _ := s.Get(id)
+	if got.GetInfo().GetLocation() != "Roswell" {
+		t.Fatalf("failed update leaked into store: %v", got)
+	}
+}
+
+// TestConcurrentReadsAndWrites запускается с -race: читатели сериализуют
+// полученные наблюдения, пока писатели их обновляют.
+func TestConcurrentReadsAndWrites(t *testing.T) {
+	s := New(8)
+	ids := make([]string, 16)
+	for i := range ids {
+		ids[i] = uuid.NewString()
+		if err := s.Create(newSighting(ids[i])); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	const iterations = 500
+	var wg sync.WaitGroup
+
+	for w := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range iterations {
+				id := ids[(w+i)%len(ids)]
+				err := s.Update(id, func(sighting *ufo_v1.Sighting) error {
+					sighting.Info.Description = uuid.NewString()
+					sighting.Info.DurationSeconds = wrapperspb.Int32(int32(i))
+					sighting.UpdatedAt = timestamppb.Now()
+					return nil
+				})
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				runtime.Gosched()
+			}
+		}()
+	}
+
+	for r := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range iterations {
+				var sightings []*ufo_v1.Sighting
+				if i%10 == 0 {
+					sightings = s.List()
+				} else if sighting, ok := s.Get(ids[(r+i)%len(ids)]); ok {
+					sightings = []*ufo_v1.Sighting{sighting}
+				}
+				for _, sighting := range sightings {
+					if _, err := proto.Marshal(sighting); err != nil {
+						t.Error(err)
+						return
+					}
+					_ = sighting.GetInfo().GetDescription()
+				}
+				runtime.Gosched()
+			}
+		}()
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for range iterations / 10 {
+			if err := s.Create(newSighting(uuid.NewString())); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+
+	wg.Wait()
+
+	if got, want := s.Len(), len(ids)+iterations/10; got != want {
+		t.Fatalf("Len() = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,241 @@
+package tlsconf
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// DefaultReloadInterval интервал проверки сертификатов на диске.
+const DefaultReloadInterval = 30 * time.Second
+
+// Config настройки TLS для сервера или клиента.
+type Config struct {
+	// CertFile и KeyFile сертификат и ключ этой стороны соединения.
+	CertFile string
+	KeyFile  string
+	// CAFile корневые сертификаты для проверки другой стороны. Если не задан,
+	// клиент использует системные корневые сертификаты.
+	CAFile string
+	// ClientAuth включает mTLS на сервере: клиент обязан предъявить сертификат,
+	// подписанный CAFile.
+	ClientAuth bool
+	// ServerName имя сервера для проверки сертификата на клиенте.
+	ServerName string
+	// ReloadInterval интервал перечитывания файлов с диска.
+	ReloadInterval time.Duration
+}
+
+// Enabled сообщает, задан ли TLS (сертификат для сервера или CA/сертификат для клиента).
+func (c Config) Enabled() bool {
+	return c.CertFile != "" || c.CAFile != ""
+}
+
+// Reloader хранит текущие сертификат и пул CA и перечитывает их с диска
+// при изменении файлов, поэтому ротация сертификатов не требует перезапуска.
+type Reloader struct {
+	cfg Config
+
+	mu      sync.RWMutex
+	cert    *tls.Certificate
+	caPool  *x509.CertPool
+	modTime map[string]time.Time
+}
+
+// NewReloader загружает сертификаты из файлов конфигурации.
+func NewReloader(cfg Config) (*Reloader, error) {
+	if cfg.ReloadInterval <= 0 {
+		cfg.ReloadInterval = DefaultReloadInterval
+	}
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") {
+		return nil, errors.New("tls: cert file and key file must be set together")
+	}
+	if cfg.ClientAuth && cfg.CAFile == "" {
+		return nil, errors.New("tls: client auth requires a CA file")
+	}
+
+	r := &Reloader{
+		cfg:     cfg,
+		modTime: make(map[string]time.Time),
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Run проверяет файлы сертификатов с интервалом ReloadInterval, пока не
+// будет отменен ctx. Ошибки перезагрузки логируются, а предыдущие
+// сертификаты продолжают использоваться.
+func (r *Reloader) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.ReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !r.changed() {
+				continue
+			}
+			if err := r.load(); err != nil {
+				slog.Warn("⚠️ Failed to reload TLS certificates", "error", err)
+				continue
+			}
+			slog.Info("🔐 TLS certificates reloaded")
+		}
+	}
+}
+
+// ClientAuthType возвращает режим проверки клиентских сертификатов.
+func (r *Reloader) ClientAuthType() tls.ClientAuthType {
+	if r.cfg.ClientAuth {
+		return tls.RequireAndVerifyClientCert
+	}
+	return tls.NoClientCert
+}
+
+// ServerConfig возвращает tls.Config для сервера. Сертификат и пул CA
+// клиентов берутся из Reloader на каждое рукопожатие.
+func (r *Reloader) ServerConfig(clientAuth tls.ClientAuthType, nextProtos ...string) *tls.Config {
+	base := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: nextProtos,
+	}
+
+	base.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+
+		cfg := base.Clone()
+		cfg.GetConfigForClient = nil
+		if r.cert != nil {
+			cfg.Certificates = []tls.Certificate{*r.cert}
+		}
+		if clientAuth != tls.NoClientCert {
+			cfg.ClientAuth = clientAuth
+			cfg.ClientCAs = r.caPool
+		}
+		return cfg, nil
+	}
+
+	return base
+}
+
+// ClientConfig возвращает tls.Config для клиента. Клиентский сертификат
+// (для mTLS) и пул CA перечитываются при ротации: если задан CAFile,
+// сертификат сервера проверяется по текущему пулу в VerifyConnection.
+func (r *Reloader) ClientConfig() *tls.Config {
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: r.cfg.ServerName,
+	}
+	if r.cfg.CAFile != "" {
+		// Стандартная проверка использует RootCAs, зафиксированный в конфиге,
+		// поэтому она отключается и выполняется в verifyServer.
+		cfg.InsecureSkipVerify = true
+		cfg.VerifyConnection = r.verifyServer
+	}
+	if r.cfg.CertFile != "" {
+		cfg.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+			return r.cert, nil
+		}
+	}
+
+	return cfg
+}
+
+// verifyServer проверяет цепочку сертификатов сервера и имя в нем по
+// текущему пулу CA, так же как это делает crypto/tls с RootCAs.
+func (r *Reloader) verifyServer(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("tls: server presented no certificates")
+	}
+
+	r.mu.RLock()
+	roots := r.caPool
+	r.mu.RUnlock()
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range cs.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := cs.PeerCertificates[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		DNSName:       cs.ServerName,
+	})
+	return err
+}
+
+func (r *Reloader) load() error {
+	var cert *tls.Certificate
+	if r.cfg.CertFile != "" {
+		c, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+		if err != nil {
+			return fmt.Errorf("tls: load key pair: %w", err)
+		}
+		cert = &c
+	}
+
+	var pool *x509.CertPool
+	if r.cfg.CAFile != "" {
+		pem, err := os.ReadFile(r.cfg.CAFile)
+		if err != nil {
+			return fmt.Errorf("tls: read CA file: %w", err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("tls: no certificates found in %s", r.cfg.CAFile)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cert = cert
+	r.caPool = pool
+	for _, file := range r.files() {
+		if info, err := os.Stat(file); err == nil {
+			r.modTime[file] = info.ModTime()
+		}
+	}
+
+	return nil
+}
+
+func (r *Reloader) changed() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			continue
+		}
+		if !info.ModTime().Equal(r.modTime[file]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Reloader) files() []string {
+	files := make([]string, 0, 3)
+	for _, f := range []string{r.cfg.CertFile, r.cfg.KeyFile, r.cfg.CAFile} {
+		if f != "" {
+			files = append(files, f)
+		}
+	}
+	return files
+}
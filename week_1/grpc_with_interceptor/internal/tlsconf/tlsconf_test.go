@@ -0,0 +1,126 @@
+package tlsconf
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T, name string) testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue выпускает сертификат сервера для dnsName, подписанный ca.
+func (ca testCA) issue(t *testing.T, dnsName string) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: dnsName},
+		DNSNames:     []string{dnsName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func TestClientConfigFollowsCARotation(t *testing.T) {
+	oldCA := newTestCA(t, "old CA")
+	newCA := newTestCA(t, "new CA")
+	oldServer := oldCA.issue(t, "localhost")
+	newServer := newCA.issue(t, "localhost")
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, oldCA.pem, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewReloader(Config{CAFile: caFile, ServerName: "localhost"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := r.ClientConfig()
+
+	verify := func(cert *x509.Certificate, serverName string) error {
+		return cfg.VerifyConnection(tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{cert},
+			ServerName:       serverName,
+		})
+	}
+
+	if err := verify(oldServer, "localhost"); err != nil {
+		t.Errorf("certificate from current CA rejected: %v", err)
+	}
+	if err := verify(newServer, "localhost"); err == nil {
+		t.Error("certificate from unknown CA accepted")
+	}
+	if err := verify(oldServer, "example.com"); err == nil {
+		t.Error("certificate for another name accepted")
+	}
+
+	if err := os.WriteFile(caFile, newCA.pem, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.load(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Конфиг создан до ротации, но проверяет уже по новому пулу.
+	if err := verify(newServer, "localhost"); err != nil {
+		t.Errorf("certificate from rotated CA rejected: %v", err)
+	}
+	if err := verify(oldServer, "localhost"); err == nil {
+		t.Error("certificate from replaced CA accepted")
+	}
+}
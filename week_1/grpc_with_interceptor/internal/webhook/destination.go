@@ -0,0 +1,118 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// errForbiddenDestination означает, что адрес получателя внутренний и не
+// входит в разрешенные сети.
+var errForbiddenDestination = errors.New("webhook destination is not allowed")
+
+// deniedNetworks внутренние и служебные сети, куда webhook не отправляются
+// без явного разрешения. Список задан явно, а не через netip.Addr.IsPrivate
+// и соседние методы: они не покрывают CGNAT, 0.0.0.0/8 и сети для тестов
+// производительности, через которые в облаках доступны внутренние сервисы.
+var deniedNetworks = withIPv4Mapped(
+	// IPv4.
+	netip.MustParsePrefix("0.0.0.0/8"),      // "эта" сеть, включая unspecified
+	netip.MustParsePrefix("10.0.0.0/8"),     // частная сеть
+	netip.MustParsePrefix("100.64.0.0/10"),  // CGNAT
+	netip.MustParsePrefix("127.0.0.0/8"),    // loopback
+	netip.MustParsePrefix("169.254.0.0/16"), // link-local, метаданные облака 169.254.169.254
+	netip.MustParsePrefix("172.16.0.0/12"),  // частная сеть
+	netip.MustParsePrefix("192.0.0.0/24"),   // IETF protocol assignments
+	netip.MustParsePrefix("192.168.0.0/16"), // частная сеть
+	netip.MustParsePrefix("198.18.0.0/15"),  // тесты производительности сетей
+	// IPv6.
+	netip.MustParsePrefix("::/128"),    // unspecified
+	netip.MustParsePrefix("::1/128"),   // loopback
+	netip.MustParsePrefix("fc00::/7"),  // unique local
+	netip.MustParsePrefix("fe80::/10"), // link-local
+	netip.MustParsePrefix("ff01::/16"), // interface-local multicast
+	netip.MustParsePrefix("ff02::/16"), // link-local multicast
+)
+
+// withIPv4Mapped дополняет список IPv4 сетей их IPv4-mapped формой
+// (::ffff:a.b.c.d), чтобы запрет не зависел от представления адреса.
+func withIPv4Mapped(prefixes ...netip.Prefix) []netip.Prefix {
+	result := slices.Clone(prefixes)
+	for _, p := range prefixes {
+		if p.Addr().Is4() {
+			mapped := netip.AddrFrom16(p.Addr().As16())
+			result = append(result, netip.PrefixFrom(mapped, p.Bits()+96))
+		}
+	}
+	return result
+}
+
+// destinationPolicy не дает отправлять webhook на внутренние адреса сервера
+// из deniedNetworks. Сети из allowed разрешаются явно.
+type destinationPolicy struct {
+	allowed []netip.Prefix
+}
+
+// check проверяет IP адрес получателя. IPv4-mapped адрес сравнивается и в
+// исходной форме, и как IPv4. Зона (fe80::1%eth0) отбрасывается: Prefix.Contains
+// не находит адреса с зоной ни в одной сети.
+func (p destinationPolicy) check(addr netip.Addr) error {
+	addr = addr.WithZone("")
+	unmapped := addr.Unmap()
+	for _, prefix := range p.allowed {
+		if prefix.Contains(addr) || prefix.Contains(unmapped) {
+			return nil
+		}
+	}
+	for _, prefix := range deniedNetworks {
+		if prefix.Contains(addr) || prefix.Contains(unmapped) {
+			return fmt.Errorf("%w: %s", errForbiddenDestination, unmapped)
+		}
+	}
+	return nil
+}
+
+// checkHost проверяет хост из URL подписки. IP адреса и localhost проверяются
+// сразу, остальные имена — при каждом соединении в control, так как DNS может
+// вернуть другой адрес, чем при создании подписки.
+func (p destinationPolicy) checkHost(host string) error {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return p.check(netip.AddrFrom4([4]byte{127, 0, 0, 1}))
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return p.check(addr)
+	}
+	return nil
+}
+
+// control хук net.Dialer: проверяет адрес, к которому действительно
+// подключается клиент после разрешения имени и на каждом редиректе.
+func (p destinationPolicy) control(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errForbiddenDestination, address)
+	}
+	return p.check(addrPort.Addr())
+}
+
+// client HTTP клиент доставки, который подключается только к разрешенным
+// адресам. Прокси из окружения не используется: иначе проверялся бы адрес
+// прокси, а не получателя.
+func (p destinationPolicy) client() *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   p.control,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Transport: transport}
+}
@@ -0,0 +1,147 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/apperr"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+)
+
+func TestSubscribeRejectsInternalDestinations(t *testing.T) {
+	tests := []struct {
+		url     string
+		allowed []netip.Prefix
+		wantErr bool
+	}{
+		{url: "http://127.0.0.1:8080/hook", wantErr: true},
+		{url: "http://localhost/hook", wantErr: true},
+		{url: "http://LOCALHOST./hook", wantErr: true},
+		{url: "http://api.localhost/hook", wantErr: true},
+		{url: "http://[::1]/hook", wantErr: true},
+		{url: "http://[::ffff:127.0.0.1]/hook", wantErr: true},
+		{url: "http://169.254.169.254/latest/meta-data", wantErr: true},
+		{url: "http://[fe80::1]/hook", wantErr: true},
+		{url: "http://10.0.0.5/hook", wantErr: true},
+		{url: "http://172.16.0.1/hook", wantErr: true},
+		{url: "http://192.168.1.10/hook", wantErr: true},
+		{url: "http://[fd00::1]/hook", wantErr: true},
+		{url: "http://0.0.0.0/hook", wantErr: true},
+		{url: "http://0.1.2.3/hook", wantErr: true},
+		{url: "http://[::]/hook", wantErr: true},
+		{url: "http://100.64.0.1/hook", wantErr: true},
+		{url: "http://100.127.255.254/hook", wantErr: true},
+		{url: "http://192.0.0.8/hook", wantErr: true},
+		{url: "http://198.18.0.1/hook", wantErr: true},
+		{url: "http://198.19.255.255/hook", wantErr: true},
+		{url: "http://[fe80::1%25eth0]/hook", wantErr: true},
+		{url: "http://[ff02::1]/hook", wantErr: true},
+		{url: "http://[::ffff:10.0.0.5]/hook", wantErr: true},
+		{url: "http://[::ffff:169.254.169.254]/hook", wantErr: true},
+		{url: "http://[::ffff:100.64.0.1]/hook", wantErr: true},
+		{url: "http://[::ffff:198.18.0.1]/hook", wantErr: true},
+		{url: "http://[::ffff:0.0.0.0]/hook", wantErr: true},
+		{url: "ftp://example.com/hook", wantErr: true},
+		{url: "http:///hook", wantErr: true},
+		{url: "https://example.com/hook"},
+		{url: "https://93.184.216.34/hook"},
+		{url: "https://100.128.0.1/hook"},
+		{url: "https://198.20.0.1/hook"},
+		{url: "https://[::ffff:93.184.216.34]/hook"},
+		{url: "https://[2606:2800:220:1:248:1893:25c8:1946]/hook"},
+		{url: "http://10.0.0.5/hook", allowed: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/24")}},
+		{url: "http://localhost:9000/hook", allowed: []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")}},
+		{url: "http://[::ffff:10.0.0.5]/hook", allowed: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/24")}},
+		{url: "http://100.64.0.1/hook", allowed: []netip.Prefix{netip.MustParsePrefix("100.64.0.0/10")}},
+		{url: "http://10.0.1.5/hook", allowed: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/24")}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			d := New(Options{AllowedNetworks: tt.allowed})
+
+			_, _, err := d.Subscribe(&ufo_v1.CreateSubscriptionRequest{
+				Url:        tt.url,
+				EventTypes: []ufo_v1.EventType{ufo_v1.EventType_EVENT_TYPE_SIGHTING_CREATED},
+			})
+			if tt.wantErr {
+				if !errors.Is(err, apperr.ErrValidation) {
+					t.Fatalf("Subscribe(%s) error = %v, want validation error", tt.url, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Subscribe(%s) error = %v, want nil", tt.url, err)
+			}
+		})
+	}
+}
+
+// TestClientChecksResolvedAddress проверяет защиту при подключении: имя,
+// которое прошло проверку при создании подписки, может разрешиться во
+// внутренний адрес (DNS rebinding).
+func TestClientChecksResolvedAddress(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	target := strings.Replace(srv.URL, "127.0.0.1", "localhost", 1)
+
+	_, err := destinationPolicy{}.client().Post(target, "application/json", nil)
+	if !errors.Is(err, errForbiddenDestination) {
+		t.Fatalf("Post to %s error = %v, want errForbiddenDestination", target, err)
+	}
+	if retryable(0, err) {
+		t.Error("forbidden destination is retryable, want dead letter at once")
+	}
+
+	allowed := destinationPolicy{allowed: []netip.Prefix{
+		netip.MustParsePrefix("127.0.0.0/8"),
+		netip.MustParsePrefix("::1/128"),
+	}}
+	resp, err := allowed.client().Post(target, "application/json", nil)
+	if err != nil {
+		t.Fatalf("Post with allowed loopback: %v", err)
+	}
+	resp.Body.Close()
+}
+
+func TestDestinationPolicyCheck(t *testing.T) {
+	tests := []struct {
+		addr    string
+		wantErr bool
+	}{
+		{addr: "127.0.0.1", wantErr: true},
+		{addr: "::ffff:127.0.0.1", wantErr: true},
+		{addr: "0.0.0.0", wantErr: true},
+		{addr: "::", wantErr: true},
+		{addr: "::ffff:0.0.0.0", wantErr: true},
+		{addr: "100.64.0.0", wantErr: true},
+		{addr: "::ffff:100.127.255.255", wantErr: true},
+		{addr: "192.0.0.170", wantErr: true},
+		{addr: "::ffff:192.0.0.170", wantErr: true},
+		{addr: "198.18.5.5", wantErr: true},
+		{addr: "fe80::1%eth0", wantErr: true},
+		{addr: "fd12:3456::1", wantErr: true},
+		{addr: "ff01::1", wantErr: true},
+		{addr: "100.63.255.255"},
+		{addr: "192.0.1.1"},
+		{addr: "198.17.255.255"},
+		{addr: "::ffff:8.8.8.8"},
+		{addr: "2001:4860:4860::8888"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			err := destinationPolicy{}.check(netip.MustParseAddr(tt.addr))
+			if tt.wantErr != errors.Is(err, errForbiddenDestination) {
+				t.Fatalf("check(%s) = %v, want forbidden: %v", tt.addr, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,340 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Options настройки доставки событий.
+type Options struct {
+	// Workers количество параллельных отправителей.
+	Workers int
+	// QueueSize емкость очереди; событие, не поместившееся в очередь, сразу
+	// попадает в dead letter, чтобы не задерживать RPC.
+	QueueSize int
+	// MaxAttempts максимальное количество попыток доставки, включая первую.
+	MaxAttempts int
+	// InitialBackoff пауза перед второй попыткой, далее удваивается до MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Timeout таймаут одного HTTP запроса.
+	Timeout time.Duration
+	// HistorySize сколько последних доставок и dead letter хранить.
+	HistorySize int
+	// AllowedNetworks сети, доставка в которые разрешена, хотя адреса в них
+	// внутренние (см. deniedNetworks). По умолчанию такие
+	// получатели отклоняются при создании подписки и при подключении.
+	AllowedNetworks []netip.Prefix
+	// Client HTTP клиент. По умолчанию клиент, который подключается только к
+	// разрешенным адресам; свой клиент эту проверку при подключении не делает.
+	Client *http.Client
+}
+
+// eventNames имена событий в заголовке X-UFO-Event и поле type.
+var eventNames = map[ufo_v1.EventType]string{
+	ufo_v1.EventType_EVENT_TYPE_SIGHTING_CREATED: "sighting.created",
+	ufo_v1.EventType_EVENT_TYPE_SIGHTING_UPDATED: "sighting.updated",
+	ufo_v1.EventType_EVENT_TYPE_SIGHTING_DELETED: "sighting.deleted",
+}
+
+// Payload тело POST запроса с событием.
+type Payload struct {
+	ID             string          `json:"id"`
+	Type           string          `json:"type"`
+	SubscriptionID string          `json:"subscription_id"`
+	OccurredAt     time.Time       `json:"occurred_at"`
+	Sighting       json.RawMessage `json:"sighting"`
+}
+
+// task одна доставка в очереди.
+type task struct {
+	delivery *ufo_v1.Delivery
+	sub      *subscription
+	event    string
+	body     []byte
+}
+
+// Dispatcher управляет подписками и в фоне доставляет события подписчикам
+// HMAC-подписанными JSON POST запросами с повторами и экспоненциальной паузой.
+type Dispatcher struct {
+	opts    Options
+	client  *http.Client
+	subs    *registry
+	history *history
+
+	mu     sync.Mutex
+	closed bool
+	queue  chan task
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New создает диспетчер. Отправка начинается после Start.
+func New(opts Options) *Dispatcher {
+	policy := destinationPolicy{allowed: opts.AllowedNetworks}
+	client := opts.Client
+	if client == nil {
+		client = policy.client()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Dispatcher{
+		opts:    opts,
+		client:  client,
+		subs:    newRegistry(policy),
+		history: newHistory(opts.HistorySize),
+		queue:   make(chan task, opts.QueueSize),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// Start запускает отправителей.
+func (d *Dispatcher) Start() {
+	for range max(d.opts.Workers, 1) {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			for t := range d.queue {
+				d.deliver(t)
+			}
+		}()
+	}
+}
+
+// Shutdown перестает принимать события и ждет, пока очередь будет доставлена.
+// Если ctx истекает раньше, текущие попытки прерываются, а недоставленные
+// события попадают в dead letter.
+func (d *Dispatcher) Shutdown(ctx context.Context) error {
+	d.mu.Lock()
+	if !d.closed {
+		d.closed = true
+		close(d.queue)
+	}
+	d.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		d.cancel()
+		<-done
+		return ctx.Err()
+	}
+}
+
+// Subscribe создает подписку и возвращает ее вместе с секретом подписи.
+func (d *Dispatcher) Subscribe(req *ufo_v1.CreateSubscriptionRequest) (*ufo_v1.Subscription, string, error) {
+	return d.subs.create(req)
+}
+
+// Subscriptions возвращает подписки в порядке создания.
+func (d *Dispatcher) Subscriptions() []*ufo_v1.Subscription {
+	return d.subs.list()
+}
+
+// Unsubscribe удаляет подписку. Уже поставленные в очередь доставки выполняются.
+func (d *Dispatcher) Unsubscribe(id string) error {
+	return d.subs.delete(id)
+}
+
+// Deliveries возвращает историю доставок, новые первыми.
+func (d *Dispatcher) Deliveries(subscriptionID string, status ufo_v1.DeliveryStatus) []*ufo_v1.Delivery {
+	return d.history.list(subscriptionID, status)
+}
+
+// Publish ставит событие в очередь для всех подходящих подписок. Не блокируется.
+func (d *Dispatcher) Publish(event ufo_v1.EventType, sighting *ufo_v1.Sighting) {
+	subs := d.subs.matching(event, sighting)
+	if len(subs) == 0 {
+		return
+	}
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(sighting)
+	if err != nil {
+		slog.Error("❌ Webhook: failed to marshal sighting", "uuid", sighting.GetUuid(), "error", err)
+		return
+	}
+
+	now := time.Now()
+	for _, sub := range subs {
+		delivery := &ufo_v1.Delivery{
+			Id:             uuid.NewString(),
+			SubscriptionId: sub.info.GetId(),
+			EventType:      event,
+			SightingUuid:   sighting.GetUuid(),
+			Status:         ufo_v1.DeliveryStatus_DELIVERY_STATUS_PENDING,
+			CreatedAt:      timestamppb.New(now),
+			UpdatedAt:      timestamppb.New(now),
+		}
+		body, err := json.Marshal(Payload{
+			ID:             delivery.Id,
+			Type:           eventNames[event],
+			SubscriptionID: delivery.SubscriptionId,
+			OccurredAt:     now.UTC(),
+			Sighting:       data,
+		})
+		if err != nil {
+			slog.Error("❌ Webhook: failed to marshal payload", "error", err)
+			continue
+		}
+
+		d.history.add(delivery)
+		d.enqueue(task{delivery: delivery, sub: sub, event: eventNames[event], body: body})
+	}
+}
+
+func (d *Dispatcher) enqueue(t task) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	reason := "dispatcher stopped"
+	if !d.closed {
+		select {
+		case d.queue <- t:
+			return
+		default:
+			reason = "queue full"
+		}
+	}
+
+	slog.Warn("☠️ Webhook dead-lettered", "delivery", t.delivery.GetId(), "url", t.sub.info.GetUrl(), "reason", reason)
+	d.history.update(t.delivery, func(dl *ufo_v1.Delivery) {
+		dl.Status = ufo_v1.DeliveryStatus_DELIVERY_STATUS_DEAD_LETTER
+		dl.LastError = reason
+	})
+}
+
+// deliver выполняет попытки доставки до успеха, неповторяемой ошибки или
+// исчерпания MaxAttempts.
+func (d *Dispatcher) deliver(t task) {
+	for attempt := 1; ; attempt++ {
+		code, err := d.send(t)
+		if err == nil {
+			d.history.update(t.delivery, func(dl *ufo_v1.Delivery) {
+				dl.Status = ufo_v1.DeliveryStatus_DELIVERY_STATUS_SUCCEEDED
+				dl.Attempts = int32(attempt)
+				dl.LastStatusCode = int32(code)
+				dl.LastError = ""
+			})
+			return
+		}
+
+		final := !retryable(code, err) || attempt >= d.opts.MaxAttempts
+		var wait <-chan time.Time
+		if !final {
+			wait = time.After(d.backoff(attempt))
+		}
+		d.history.update(t.delivery, func(dl *ufo_v1.Delivery) {
+			dl.Attempts = int32(attempt)
+			dl.LastStatusCode = int32(code)
+			dl.LastError = err.Error()
+			if final {
+				dl.Status = ufo_v1.DeliveryStatus_DELIVERY_STATUS_DEAD_LETTER
+			}
+		})
+		if final {
+			slog.Warn("☠️ Webhook dead-lettered", "delivery", t.delivery.GetId(), "url", t.sub.info.GetUrl(), "attempts", attempt, "error", err)
+			return
+		}
+		slog.Warn("⚠️ Webhook attempt failed", "delivery", t.delivery.GetId(), "url", t.sub.info.GetUrl(), "attempt", attempt, "error", err)
+
+		select {
+		case <-wait:
+		case <-d.ctx.Done():
+			d.history.update(t.delivery, func(dl *ufo_v1.Delivery) {
+				dl.Status = ufo_v1.DeliveryStatus_DELIVERY_STATUS_DEAD_LETTER
+				dl.LastError = "dispatcher stopped: " + dl.LastError
+			})
+			return
+		}
+	}
+}
+
+// send выполняет одну попытку и возвращает HTTP код ответа (0, если ответа не было).
+func (d *Dispatcher) send(t task) (int, error) {
+	ctx := d.ctx
+	if d.opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.opts.Timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.sub.info.GetUrl(), bytes.NewReader(t.body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "ufo-webhook/1")
+	req.Header.Set(HeaderEvent, t.event)
+	req.Header.Set(HeaderDelivery, t.delivery.GetId())
+	req.Header.Set(HeaderSignature, Sign(t.sub.secret, time.Now(), t.body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// Дочитываем ответ, чтобы соединение вернулось в пул.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// retryable сетевые ошибки, таймауты, 408, 429 и 5xx повторяются;
+// остальные ответы 4xx и запрещенный адрес получателя считаются отказом.
+func retryable(code int, err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, errForbiddenDestination) {
+		return false
+	}
+	switch {
+	case code == 0:
+		return true
+	case code == http.StatusRequestTimeout, code == http.StatusTooManyRequests:
+		return true
+	default:
+		return code >= 500
+	}
+}
+
+// backoff InitialBackoff * 2^(attempt-1), не больше MaxBackoff. Фактическая пауза
+// выбирается случайно из второй половины, чтобы повторы разных доставок не совпадали.
+func (d *Dispatcher) backoff(attempt int) time.Duration {
+	pause := d.opts.InitialBackoff
+	for i := 1; i < attempt && pause < d.opts.MaxBackoff; i++ {
+		pause *= 2
+	}
+	if d.opts.MaxBackoff > 0 {
+		pause = min(pause, d.opts.MaxBackoff)
+	}
+	if pause <= 0 {
+		return 0
+	}
+	return pause/2 + rand.N(pause/2+1)
+}
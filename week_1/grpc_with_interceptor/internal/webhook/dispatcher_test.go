@@ -0,0 +1,264 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const testSecret = "test-secret"
+
+func testOptions() Options {
+	return Options{
+		Workers:        2,
+		QueueSize:      16,
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+		Timeout:        time.Second,
+		HistorySize:    100,
+		// Тестовые получатели слушают loopback.
+		AllowedNetworks: []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8"), netip.MustParsePrefix("::1/128")},
+	}
+}
+
+func newTestDispatcher(t *testing.T, opts Options) *Dispatcher {
+	t.Helper()
+
+	d := New(opts)
+	d.Start()
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := d.Shutdown(ctx); err != nil {
+			t.Errorf("Shutdown: %v", err)
+		}
+	})
+	return d
+}
+
+func subscribe(t *testing.T, d *Dispatcher, url string, filter *ufo_v1.SubscriptionFilter, events ...ufo_v1.EventType) *ufo_v1.Subscription {
+	t.Helper()
+
+	sub, _, err := d.Subscribe(&ufo_v1.CreateSubscriptionRequest{
+		Url:        url,
+		EventTypes: events,
+		Filter:     filter,
+		Secret:     testSecret,
+	})
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	return sub
+}
+
+func testSighting(location, color string) *ufo_v1.Sighting {
+	return &ufo_v1.Sighting{
+		Uuid: "3f0c6b8e-4a51-4d6e-9b7a-2f6f1f0b9c11",
+		Info: &ufo_v1.SightingInfo{
+			Location: location,
+			Color:    wrapperspb.String(color),
+		},
+	}
+}
+
+// waitDelivery ждет, пока единственная доставка подписки выйдет из PENDING.
+func waitDelivery(t *testing.T, d *Dispatcher, subscriptionID string) *ufo_v1.Delivery {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		deliveries := d.Deliveries(subscriptionID, ufo_v1.DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED)
+		if len(deliveries) == 1 && deliveries[0].GetStatus() != ufo_v1.DeliveryStatus_DELIVERY_STATUS_PENDING {
+			return deliveries[0]
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("delivery for subscription %s not finished", subscriptionID)
+	return nil
+}
+
+func TestDispatcherDeliversSignedEvent(t *testing.T) {
+	received := make(chan Payload, 1)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if err := Verify([]byte(testSecret), r.Header.Get(HeaderSignature), body, time.Now(), time.Minute); err != nil {
+			t.Errorf("Verify: %v", err)
+		}
+		if got := r.Header.Get(HeaderEvent); got != "sighting.created" {
+			t.Errorf("%s = %q, want sighting.created", HeaderEvent, got)
+		}
+
+		var p Payload
+		if err := json.Unmarshal(body, &p); err != nil {
+			t.Errorf("unmarshal payload: %v", err)
+		}
+		if got := r.Header.Get(HeaderDelivery); got != p.ID {
+			t.Errorf("%s = %q, want payload id %q", HeaderDelivery, got, p.ID)
+		}
+		received <- p
+	}))
+	defer receiver.Close()
+
+	d := newTestDispatcher(t, testOptions())
+	sub := subscribe(t, d, receiver.URL, nil, ufo_v1.EventType_EVENT_TYPE_SIGHTING_CREATED)
+
+	d.Publish(ufo_v1.EventType_EVENT_TYPE_SIGHTING_CREATED, testSighting("Moscow", "green"))
+
+	p := <-received
+	if p.Type != "sighting.created" || p.SubscriptionID != sub.GetId() {
+		t.Errorf("payload = %+v", p)
+	}
+	var sighting struct {
+		UUID string `json:"uuid"`
+	}
+	if err := json.Unmarshal(p.Sighting, &sighting); err != nil || sighting.UUID == "" {
+		t.Errorf("payload sighting = %s (%v)", p.Sighting, err)
+	}
+
+	got := waitDelivery(t, d, sub.GetId())
+	if got.GetStatus() != ufo_v1.DeliveryStatus_DELIVERY_STATUS_SUCCEEDED || got.GetAttempts() != 1 || got.GetLastStatusCode() != http.StatusOK {
+		t.Errorf("delivery = %v", got)
+	}
+}
+
+func TestDispatcherRetriesUntilSuccess(t *testing.T) {
+	var calls atomic.Int32
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	d := newTestDispatcher(t, testOptions())
+	sub := subscribe(t, d, receiver.URL, nil, ufo_v1.EventType_EVENT_TYPE_SIGHTING_UPDATED)
+
+	d.Publish(ufo_v1.EventType_EVENT_TYPE_SIGHTING_UPDATED, testSighting("Moscow", "green"))
+
+	got := waitDelivery(t, d, sub.GetId())
+	if got.GetStatus() != ufo_v1.DeliveryStatus_DELIVERY_STATUS_SUCCEEDED || got.GetAttempts() != 3 {
+		t.Errorf("delivery = %v, want succeeded on 3rd attempt", got)
+	}
+	if dead := d.Deliveries("", ufo_v1.DeliveryStatus_DELIVERY_STATUS_DEAD_LETTER); len(dead) != 0 {
+		t.Errorf("dead letters = %v, want none", dead)
+	}
+}
+
+func TestDispatcherDeadLetter(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		wantAttempts int32
+	}{
+		{name: "retries exhausted", status: http.StatusInternalServerError, wantAttempts: 3},
+		{name: "permanent rejection", status: http.StatusBadRequest, wantAttempts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				w.WriteHeader(tt.status)
+			}))
+			defer receiver.Close()
+
+			d := newTestDispatcher(t, testOptions())
+			sub := subscribe(t, d, receiver.URL, nil, ufo_v1.EventType_EVENT_TYPE_SIGHTING_DELETED)
+
+			d.Publish(ufo_v1.EventType_EVENT_TYPE_SIGHTING_DELETED, testSighting("Moscow", "green"))
+
+			got := waitDelivery(t, d, sub.GetId())
+			if got.GetStatus() != ufo_v1.DeliveryStatus_DELIVERY_STATUS_DEAD_LETTER || got.GetAttempts() != tt.wantAttempts {
+				t.Errorf("delivery = %v, want dead letter after %d attempt(s)", got, tt.wantAttempts)
+			}
+			if got.GetLastStatusCode() != int32(tt.status) {
+				t.Errorf("last status code = %d, want %d", got.GetLastStatusCode(), tt.status)
+			}
+			if calls.Load() != tt.wantAttempts {
+				t.Errorf("receiver calls = %d, want %d", calls.Load(), tt.wantAttempts)
+			}
+
+			dead := d.Deliveries(sub.GetId(), ufo_v1.DeliveryStatus_DELIVERY_STATUS_DEAD_LETTER)
+			if len(dead) != 1 || dead[0].GetId() != got.GetId() {
+				t.Errorf("dead letters = %v, want [%s]", dead, got.GetId())
+			}
+		})
+	}
+}
+
+func TestDispatcherSubscriptionSelection(t *testing.T) {
+	var calls atomic.Int32
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	defer receiver.Close()
+
+	d := newTestDispatcher(t, testOptions())
+	filter := &ufo_v1.SubscriptionFilter{LocationContains: "mos", Colors: []string{"Green", "blue"}}
+	sub := subscribe(t, d, receiver.URL, filter, ufo_v1.EventType_EVENT_TYPE_SIGHTING_CREATED)
+
+	tests := []struct {
+		name     string
+		event    ufo_v1.EventType
+		sighting *ufo_v1.Sighting
+		want     bool
+	}{
+		{name: "match", event: ufo_v1.EventType_EVENT_TYPE_SIGHTING_CREATED, sighting: testSighting("Moscow", "green"), want: true},
+		{name: "other event", event: ufo_v1.EventType_EVENT_TYPE_SIGHTING_UPDATED, sighting: testSighting("Moscow", "green")},
+		{name: "other location", event: ufo_v1.EventType_EVENT_TYPE_SIGHTING_CREATED, sighting: testSighting("Roswell", "green")},
+		{name: "other color", event: ufo_v1.EventType_EVENT_TYPE_SIGHTING_CREATED, sighting: testSighting("Moscow", "red")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := len(d.Deliveries(sub.GetId(), ufo_v1.DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED))
+			d.Publish(tt.event, tt.sighting)
+			after := len(d.Deliveries(sub.GetId(), ufo_v1.DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED))
+			if got := after > before; got != tt.want {
+				t.Errorf("delivery queued = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if err := d.Unsubscribe(sub.GetId()); err != nil {
+		t.Fatalf("Unsubscribe: %v", err)
+	}
+	if err := d.Unsubscribe(sub.GetId()); err == nil {
+		t.Error("second Unsubscribe succeeded, want not found")
+	}
+}
+
+func TestVerifyRejectsTampering(t *testing.T) {
+	body := []byte(`{"id":"1"}`)
+	now := time.Now()
+	header := Sign([]byte(testSecret), now, body)
+
+	if err := Verify([]byte(testSecret), header, body, now, time.Minute); err != nil {
+		t.Fatalf("Verify valid signature: %v", err)
+	}
+	if err := Verify([]byte("other"), header, body, now, time.Minute); err != ErrSignatureMismatch {
+		t.Errorf("wrong secret: err = %v, want %v", err, ErrSignatureMismatch)
+	}
+	if err := Verify([]byte(testSecret), header, []byte(`{"id":"2"}`), now, time.Minute); err != ErrSignatureMismatch {
+		t.Errorf("tampered body: err = %v, want %v", err, ErrSignatureMismatch)
+	}
+	if err := Verify([]byte(testSecret), header, body, now.Add(time.Hour), time.Minute); err == nil {
+		t.Error("stale signature accepted")
+	}
+	if err := Verify([]byte(testSecret), "garbage", body, now, time.Minute); err != ErrMalformedSignature {
+		t.Errorf("malformed header: err = %v, want %v", err, ErrMalformedSignature)
+	}
+}
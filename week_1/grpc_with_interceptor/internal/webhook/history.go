@@ -0,0 +1,78 @@
+package webhook
+
+import (
+	"sync"
+	"time"
+
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// history ограниченная история доставок и отдельный список dead letter.
+// Dead letter хранятся отдельно, чтобы поток успешных доставок не вытеснял
+// события, которые получатель так и не принял.
+type history struct {
+	mu          sync.Mutex
+	limit       int
+	deliveries  []*ufo_v1.Delivery // от старых к новым
+	deadLetters []*ufo_v1.Delivery
+}
+
+func newHistory(limit int) *history {
+	return &history{limit: limit}
+}
+
+// add регистрирует новую доставку в состоянии PENDING.
+func (h *history) add(d *ufo_v1.Delivery) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.deliveries = appendBounded(h.deliveries, d, h.limit)
+}
+
+// update меняет состояние доставки под блокировкой истории.
+// Доставка в DEAD_LETTER дополнительно попадает в список dead letter.
+func (h *history) update(d *ufo_v1.Delivery, fn func(d *ufo_v1.Delivery)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	fn(d)
+	d.UpdatedAt = timestamppb.New(time.Now())
+	if d.GetStatus() == ufo_v1.DeliveryStatus_DELIVERY_STATUS_DEAD_LETTER {
+		h.deadLetters = appendBounded(h.deadLetters, d, h.limit)
+	}
+}
+
+// list возвращает копии доставок, новые первыми. Статус DEAD_LETTER
+// читается из списка dead letter, остальные — из общей истории.
+func (h *history) list(subscriptionID string, status ufo_v1.DeliveryStatus) []*ufo_v1.Delivery {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	source := h.deliveries
+	if status == ufo_v1.DeliveryStatus_DELIVERY_STATUS_DEAD_LETTER {
+		source = h.deadLetters
+	}
+
+	var result []*ufo_v1.Delivery
+	for i := len(source) - 1; i >= 0; i-- {
+		d := source[i]
+		if subscriptionID != "" && d.GetSubscriptionId() != subscriptionID {
+			continue
+		}
+		if status != ufo_v1.DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED && d.GetStatus() != status {
+			continue
+		}
+		result = append(result, proto.CloneOf(d))
+	}
+	return result
+}
+
+func appendBounded(list []*ufo_v1.Delivery, d *ufo_v1.Delivery, limit int) []*ufo_v1.Delivery {
+	if limit > 0 && len(list) >= limit {
+		copy(list, list[1:])
+		list = list[:len(list)-1]
+	}
+	return append(list, d)
+}
@@ -0,0 +1,86 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Заголовки запроса с событием.
+const (
+	// HeaderSignature подпись тела вида "t=<unix>,v1=<hex hmac-sha256>".
+	HeaderSignature = "X-UFO-Signature"
+	// HeaderEvent тип события (sighting.created и т.д.).
+	HeaderEvent = "X-UFO-Event"
+	// HeaderDelivery идентификатор доставки, одинаковый для всех повторов.
+	HeaderDelivery = "X-UFO-Delivery"
+)
+
+// Ошибки проверки подписи.
+var (
+	ErrMalformedSignature = errors.New("webhook: malformed signature header")
+	ErrSignatureMismatch  = errors.New("webhook: signature mismatch")
+	ErrSignatureExpired   = errors.New("webhook: signature timestamp outside tolerance")
+)
+
+// Sign возвращает значение заголовка X-UFO-Signature для тела запроса.
+// Подписывается строка "<unix timestamp>.<body>", поэтому перехваченный
+// запрос нельзя переотправить с другим временем.
+func Sign(secret []byte, ts time.Time, body []byte) string {
+	unix := strconv.FormatInt(ts.Unix(), 10)
+	return "t=" + unix + ",v1=" + hex.EncodeToString(mac(secret, unix, body))
+}
+
+// Verify проверяет заголовок X-UFO-Signature на стороне получателя.
+// tolerance ограничивает расхождение времени подписи и now (0 — не проверять).
+func Verify(secret []byte, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var unix string
+	var sigs [][]byte
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return ErrMalformedSignature
+		}
+		switch key {
+		case "t":
+			unix = value
+		case "v1":
+			sig, err := hex.DecodeString(value)
+			if err != nil {
+				return ErrMalformedSignature
+			}
+			sigs = append(sigs, sig)
+		}
+	}
+
+	sec, err := strconv.ParseInt(unix, 10, 64)
+	if err != nil || len(sigs) == 0 {
+		return ErrMalformedSignature
+	}
+	if tolerance > 0 {
+		if d := now.Sub(time.Unix(sec, 0)); d > tolerance || d < -tolerance {
+			return fmt.Errorf("%w: %v", ErrSignatureExpired, d)
+		}
+	}
+
+	expected := mac(secret, unix, body)
+	for _, sig := range sigs {
+		if hmac.Equal(sig, expected) {
+			return nil
+		}
+	}
+	return ErrSignatureMismatch
+}
+
+func mac(secret []byte, unix string, body []byte) []byte {
+	h := hmac.New(sha256.New, secret)
+	h.Write([]byte(unix))
+	h.Write([]byte{'.'})
+	h.Write(body)
+	return h.Sum(nil)
+}
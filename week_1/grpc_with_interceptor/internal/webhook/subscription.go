@@ -0,0 +1,153 @@
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/apperr"
+	palette "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/internal/colors"
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// secretPrefix отличает сгенерированные сервером секреты от заданных клиентом.
+const secretPrefix = "whsec_"
+
+// subscription подписка вместе с секретом, который наружу отдается только при создании.
+type subscription struct {
+	info   *ufo_v1.Subscription
+	secret []byte
+}
+
+// matches проверяет, нужно ли отправлять событие по подписке.
+func (s *subscription) matches(event ufo_v1.EventType, sighting *ufo_v1.Sighting) bool {
+	if !slices.Contains(s.info.GetEventTypes(), event) {
+		return false
+	}
+
+	filter := s.info.GetFilter()
+	info := sighting.GetInfo()
+	if sub := filter.GetLocationContains(); sub != "" &&
+		!strings.Contains(strings.ToLower(info.GetLocation()), strings.ToLower(sub)) {
+		return false
+	}
+	if colors := filter.GetColors(); len(colors) > 0 {
+		// Цвет из фильтра сравнивается и как строка, и по палитре: "red"
+		// совпадает с "#ff0000" и "красный".
+		color, normalized := info.GetColor().GetValue(), info.GetNormalizedColor()
+		if !slices.ContainsFunc(colors, func(c string) bool {
+			if strings.EqualFold(c, color) {
+				return true
+			}
+			return normalized != ufo_v1.Color_COLOR_UNSPECIFIED && palette.Normalize(c) == normalized
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// registry хранит подписки в памяти. Подписки не попадают в WAL
+// хранилища наблюдений и после перезапуска оформляются заново.
+type registry struct {
+	policy destinationPolicy
+
+	mu   sync.RWMutex
+	subs map[string]*subscription
+}
+
+func newRegistry(policy destinationPolicy) *registry {
+	return &registry{policy: policy, subs: make(map[string]*subscription)}
+}
+
+func (r *registry) create(req *ufo_v1.CreateSubscriptionRequest) (*ufo_v1.Subscription, string, error) {
+	if problem := r.checkURL(req.GetUrl()); problem != "" {
+		return nil, "", apperr.Validation("validation error", &errdetails.BadRequest_FieldViolation{
+			Field:       "url",
+			Description: problem,
+		})
+	}
+
+	secret := req.GetSecret()
+	if secret == "" {
+		buf := make([]byte, 24)
+		_, _ = rand.Read(buf)
+		secret = secretPrefix + hex.EncodeToString(buf)
+	}
+
+	info := &ufo_v1.Subscription{
+		Id:         uuid.NewString(),
+		Url:        req.GetUrl(),
+		EventTypes: slices.Compact(slices.Sorted(slices.Values(req.GetEventTypes()))),
+		Filter:     proto.CloneOf(req.GetFilter()),
+		CreatedAt:  timestamppb.New(time.Now()),
+	}
+
+	r.mu.Lock()
+	r.subs[info.Id] = &subscription{info: info, secret: []byte(secret)}
+	r.mu.Unlock()
+
+	return proto.CloneOf(info), secret, nil
+}
+
+// checkURL возвращает описание ошибки URL подписки или пустую строку.
+func (r *registry) checkURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return "scheme must be http or https"
+	}
+	if u.Hostname() == "" {
+		return "host is required"
+	}
+	if err := r.policy.checkHost(u.Hostname()); err != nil {
+		return "destination must not be a loopback, link-local, private or reserved address"
+	}
+	return ""
+}
+
+func (r *registry) list() []*ufo_v1.Subscription {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*ufo_v1.Subscription, 0, len(r.subs))
+	for _, s := range r.subs {
+		result = append(result, proto.CloneOf(s.info))
+	}
+	slices.SortFunc(result, func(a, b *ufo_v1.Subscription) int {
+		return a.GetCreatedAt().AsTime().Compare(b.GetCreatedAt().AsTime())
+	})
+	return result
+}
+
+func (r *registry) delete(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.subs[id]; !ok {
+		return apperr.SubscriptionNotFound(id)
+	}
+	delete(r.subs, id)
+	return nil
+}
+
+// matching возвращает подписки, которым нужно доставить событие.
+func (r *registry) matching(event ufo_v1.EventType, sighting *ufo_v1.Sighting) []*subscription {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*subscription
+	for _, s := range r.subs {
+		if s.matches(event, sighting) {
+			result = append(result, s)
+		}
+	}
+	return result
+}
@@ -0,0 +1,289 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: ufo/storage/v1/storage.proto
+
+package storage_v1
+
+import (
+	v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// WALRecord одна мутация хранилища в журнале упреждающей записи
+type WALRecord struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sequence монотонно возрастающий номер записи
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// written_at время записи в журнал
+	WrittenAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=written_at,json=writtenAt,proto3" json:"written_at,omitempty"`
+	// Types that are valid to be assigned to Mutation:
+	//
+	//	*WALRecord_Create
+	//	*WALRecord_Update
+	//	*WALRecord_Delete
+	Mutation      isWALRecord_Mutation `protobuf_oneof:"mutation"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WALRecord) Reset() {
+	*x = WALRecord{}
+	mi := &file_ufo_storage_v1_storage_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WALRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WALRecord) ProtoMessage() {}
+
+func (x *WALRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_storage_v1_storage_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WALRecord.ProtoReflect.Descriptor instead.
+func (*WALRecord) Descriptor() ([]byte, []int) {
+	return file_ufo_storage_v1_storage_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WALRecord) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *WALRecord) GetWrittenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WrittenAt
+	}
+	return nil
+}
+
+func (x *WALRecord) GetMutation() isWALRecord_Mutation {
+	if x != nil {
+		return x.Mutation
+	}
+	return nil
+}
+
+func (x *WALRecord) GetCreate() *v1.Sighting {
+	if x != nil {
+		if x, ok := x.Mutation.(*WALRecord_Create); ok {
+			return x.Create
+		}
+	}
+	return nil
+}
+
+func (x *WALRecord) GetUpdate() *v1.Sighting {
+	if x != nil {
+		if x, ok := x.Mutation.(*WALRecord_Update); ok {
+			return x.Update
+		}
+	}
+	return nil
+}
+
+func (x *WALRecord) GetDelete() string {
+	if x != nil {
+		if x, ok := x.Mutation.(*WALRecord_Delete); ok {
+			return x.Delete
+		}
+	}
+	return ""
+}
+
+type isWALRecord_Mutation interface {
+	isWALRecord_Mutation()
+}
+
+type WALRecord_Create struct {
+	// create новое наблюдение
+	Create *v1.Sighting `protobuf:"bytes,3,opt,name=create,proto3,oneof"`
+}
+
+type WALRecord_Update struct {
+	// update новая версия существующего наблюдения (включая мягкое удаление)
+	Update *v1.Sighting `protobuf:"bytes,4,opt,name=update,proto3,oneof"`
+}
+
+type WALRecord_Delete struct {
+	// delete uuid наблюдения, удаленного из хранилища окончательно
+	Delete string `protobuf:"bytes,5,opt,name=delete,proto3,oneof"`
+}
+
+func (*WALRecord_Create) isWALRecord_Mutation() {}
+
+func (*WALRecord_Update) isWALRecord_Mutation() {}
+
+func (*WALRecord_Delete) isWALRecord_Mutation() {}
+
+// Snapshot полное состояние хранилища на момент записи sequence журнала
+type Snapshot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sequence номер последней записи журнала, учтенной в снимке
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// created_at время создания снимка
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// sightings все наблюдения
+	Sightings     []*v1.Sighting `protobuf:"bytes,3,rep,name=sightings,proto3" json:"sightings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	mi := &file_ufo_storage_v1_storage_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_storage_v1_storage_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_ufo_storage_v1_storage_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Snapshot) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Snapshot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Snapshot) GetSightings() []*v1.Sighting {
+	if x != nil {
+		return x.Sightings
+	}
+	return nil
+}
+
+var File_ufo_storage_v1_storage_proto protoreflect.FileDescriptor
+
+const file_ufo_storage_v1_storage_proto_rawDesc = "" +
+	"\n" +
+	"\x1cufo/storage/v1/storage.proto\x12\x0eufo.storage.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x10ufo/v1/ufo.proto\"\xe0\x01\n" +
+	"\tWALRecord\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x129\n" +
+	"\n" +
+	"written_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\twrittenAt\x12*\n" +
+	"\x06create\x18\x03 \x01(\v2\x10.ufo.v1.SightingH\x00R\x06create\x12*\n" +
+	"\x06update\x18\x04 \x01(\v2\x10.ufo.v1.SightingH\x00R\x06update\x12\x18\n" +
+	"\x06delete\x18\x05 \x01(\tH\x00R\x06deleteB\n" +
+	"\n" +
+	"\bmutation\"\x91\x01\n" +
+	"\bSnapshot\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12.\n" +
+	"\tsightings\x18\x03 \x03(\v2\x10.ufo.v1.SightingR\tsightingsBbZ`github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/storage/v1;storage_v1b\x06proto3"
+
+var (
+	file_ufo_storage_v1_storage_proto_rawDescOnce sync.Once
+	file_ufo_storage_v1_storage_proto_rawDescData []byte
+)
+
+func file_ufo_storage_v1_storage_proto_rawDescGZIP() []byte {
+	file_ufo_storage_v1_storage_proto_rawDescOnce.Do(func() {
+		file_ufo_storage_v1_storage_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ufo_storage_v1_storage_proto_rawDesc), len(file_ufo_storage_v1_storage_proto_rawDesc)))
+	})
+	return file_ufo_storage_v1_storage_proto_rawDescData
+}
+
+var file_ufo_storage_v1_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_ufo_storage_v1_storage_proto_goTypes = []any{
+	(*WALRecord)(nil),             // 0: ufo.storage.v1.WALRecord
+	(*Snapshot)(nil),              // 1: ufo.storage.v1.Snapshot
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(*v1.Sighting)(nil),           // 3: ufo.v1.Sighting
+}
+var file_ufo_storage_v1_storage_proto_depIdxs = []int32{
+	2, // 0: ufo.storage.v1.WALRecord.written_at:type_name -> google.protobuf.Timestamp
+	3, // 1: ufo.storage.v1.WALRecord.create:type_name -> ufo.v1.Sighting
+	3, // 2: ufo.storage.v1.WALRecord.update:type_name -> ufo.v1.Sighting
+	2, // 3: ufo.storage.v1.Snapshot.created_at:type_name -> google.protobuf.Timestamp
+	3, // 4: ufo.storage.v1.Snapshot.sightings:type_name -> ufo.v1.Sighting
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_ufo_storage_v1_storage_proto_init() }
+func file_ufo_storage_v1_storage_proto_init() {
+	if File_ufo_storage_v1_storage_proto != nil {
+		return
+	}
+	file_ufo_storage_v1_storage_proto_msgTypes[0].OneofWrappers = []any{
+		(*WALRecord_Create)(nil),
+		(*WALRecord_Update)(nil),
+		(*WALRecord_Delete)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ufo_storage_v1_storage_proto_rawDesc), len(file_ufo_storage_v1_storage_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ufo_storage_v1_storage_proto_goTypes,
+		DependencyIndexes: file_ufo_storage_v1_storage_proto_depIdxs,
+		MessageInfos:      file_ufo_storage_v1_storage_proto_msgTypes,
+	}.Build()
+	File_ufo_storage_v1_storage_proto = out.File
+	file_ufo_storage_v1_storage_proto_goTypes = nil
+	file_ufo_storage_v1_storage_proto_depIdxs = nil
+}
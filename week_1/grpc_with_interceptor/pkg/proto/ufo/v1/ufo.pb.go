@@ -26,22 +26,221 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Color каноническая палитра цветов для статистики и отбора наблюдений
+type Color int32
+
+const (
+	// COLOR_UNSPECIFIED цвет не указан или не распознан
+	Color_COLOR_UNSPECIFIED Color = 0
+	Color_COLOR_RED         Color = 1
+	Color_COLOR_ORANGE      Color = 2
+	Color_COLOR_YELLOW      Color = 3
+	Color_COLOR_GREEN       Color = 4
+	Color_COLOR_CYAN        Color = 5
+	Color_COLOR_BLUE        Color = 6
+	Color_COLOR_PURPLE      Color = 7
+	Color_COLOR_PINK        Color = 8
+	Color_COLOR_BROWN       Color = 9
+	Color_COLOR_WHITE       Color = 10
+	Color_COLOR_GRAY        Color = 11
+	Color_COLOR_BLACK       Color = 12
+)
+
+// Enum value maps for Color.
+var (
+	Color_name = map[int32]string{
+		0:  "COLOR_UNSPECIFIED",
+		1:  "COLOR_RED",
+		2:  "COLOR_ORANGE",
+		3:  "COLOR_YELLOW",
+		4:  "COLOR_GREEN",
+		5:  "COLOR_CYAN",
+		6:  "COLOR_BLUE",
+		7:  "COLOR_PURPLE",
+		8:  "COLOR_PINK",
+		9:  "COLOR_BROWN",
+		10: "COLOR_WHITE",
+		11: "COLOR_GRAY",
+		12: "COLOR_BLACK",
+	}
+	Color_value = map[string]int32{
+		"COLOR_UNSPECIFIED": 0,
+		"COLOR_RED":         1,
+		"COLOR_ORANGE":      2,
+		"COLOR_YELLOW":      3,
+		"COLOR_GREEN":       4,
+		"COLOR_CYAN":        5,
+		"COLOR_BLUE":        6,
+		"COLOR_PURPLE":      7,
+		"COLOR_PINK":        8,
+		"COLOR_BROWN":       9,
+		"COLOR_WHITE":       10,
+		"COLOR_GRAY":        11,
+		"COLOR_BLACK":       12,
+	}
+)
+
+func (x Color) Enum() *Color {
+	p := new(Color)
+	*p = x
+	return p
+}
+
+func (x Color) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Color) Descriptor() protoreflect.EnumDescriptor {
+	return file_ufo_v1_ufo_proto_enumTypes[0].Descriptor()
+}
+
+func (Color) Type() protoreflect.EnumType {
+	return &file_ufo_v1_ufo_proto_enumTypes[0]
+}
+
+func (x Color) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Color.Descriptor instead.
+func (Color) EnumDescriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{0}
+}
+
+// EventType тип события наблюдения для webhook подписок
+type EventType int32
+
+const (
+	EventType_EVENT_TYPE_UNSPECIFIED EventType = 0
+	// EVENT_TYPE_SIGHTING_CREATED создано новое наблюдение
+	EventType_EVENT_TYPE_SIGHTING_CREATED EventType = 1
+	// EVENT_TYPE_SIGHTING_UPDATED наблюдение обновлено
+	EventType_EVENT_TYPE_SIGHTING_UPDATED EventType = 2
+	// EVENT_TYPE_SIGHTING_DELETED наблюдение удалено
+	EventType_EVENT_TYPE_SIGHTING_DELETED EventType = 3
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0: "EVENT_TYPE_UNSPECIFIED",
+		1: "EVENT_TYPE_SIGHTING_CREATED",
+		2: "EVENT_TYPE_SIGHTING_UPDATED",
+		3: "EVENT_TYPE_SIGHTING_DELETED",
+	}
+	EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED":      0,
+		"EVENT_TYPE_SIGHTING_CREATED": 1,
+		"EVENT_TYPE_SIGHTING_UPDATED": 2,
+		"EVENT_TYPE_SIGHTING_DELETED": 3,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ufo_v1_ufo_proto_enumTypes[1].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_ufo_v1_ufo_proto_enumTypes[1]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{1}
+}
+
+// DeliveryStatus состояние доставки события
+type DeliveryStatus int32
+
+const (
+	DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED DeliveryStatus = 0
+	// DELIVERY_STATUS_PENDING доставка в очереди или ждет повторной попытки
+	DeliveryStatus_DELIVERY_STATUS_PENDING DeliveryStatus = 1
+	// DELIVERY_STATUS_SUCCEEDED получатель ответил 2xx
+	DeliveryStatus_DELIVERY_STATUS_SUCCEEDED DeliveryStatus = 2
+	// DELIVERY_STATUS_DEAD_LETTER попытки исчерпаны или ошибка не подлежит повтору
+	DeliveryStatus_DELIVERY_STATUS_DEAD_LETTER DeliveryStatus = 3
+)
+
+// Enum value maps for DeliveryStatus.
+var (
+	DeliveryStatus_name = map[int32]string{
+		0: "DELIVERY_STATUS_UNSPECIFIED",
+		1: "DELIVERY_STATUS_PENDING",
+		2: "DELIVERY_STATUS_SUCCEEDED",
+		3: "DELIVERY_STATUS_DEAD_LETTER",
+	}
+	DeliveryStatus_value = map[string]int32{
+		"DELIVERY_STATUS_UNSPECIFIED": 0,
+		"DELIVERY_STATUS_PENDING":     1,
+		"DELIVERY_STATUS_SUCCEEDED":   2,
+		"DELIVERY_STATUS_DEAD_LETTER": 3,
+	}
+)
+
+func (x DeliveryStatus) Enum() *DeliveryStatus {
+	p := new(DeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x DeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ufo_v1_ufo_proto_enumTypes[2].Descriptor()
+}
+
+func (DeliveryStatus) Type() protoreflect.EnumType {
+	return &file_ufo_v1_ufo_proto_enumTypes[2]
+}
+
+func (x DeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeliveryStatus.Descriptor instead.
+func (DeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{2}
+}
+
+// SightingInfo базовая информация о наблюдении НЛО
 type SightingInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// observed_at время наблюдения НЛО
+	// observed_at время наблюдения НЛО, не в будущем (проверяется сервером, не правилами validate)
 	ObservedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=observed_at,json=observedAt,proto3" json:"observed_at,omitempty"`
 	// location место наблюдения
 	Location string `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
-	// description описание наблюдаемого объекта
+	// description описание наблюдаемого объекта, до 1000 символов
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// color цвет объекта (опционально)
+	// color цвет объекта в свободной форме (опционально): название на русском или
+	// английском, #rgb, #rrggbb или rgb(r, g, b)
 	Color *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
 	// sound признак наличия звука (опционально)
 	Sound *wrapperspb.BoolValue `protobuf:"bytes,5,opt,name=sound,proto3" json:"sound,omitempty"`
-	// duration_seconds продолжительность наблюдения в секундах (опционально)
+	// duration_seconds продолжительность наблюдения в секундах, от 1 до 86400 (опционально)
 	DurationSeconds *wrapperspb.Int32Value `protobuf:"bytes,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// normalized_color цвет из палитры, вычисляется сервером по color; color хранит исходную строку
+	NormalizedColor Color `protobuf:"varint,7,opt,name=normalized_color,json=normalizedColor,proto3,enum=ufo.v1.Color" json:"normalized_color,omitempty"`
+	// coordinates координаты места наблюдения (опционально), в API v2 — location.coordinates
+	Coordinates   *Coordinates `protobuf:"bytes,8,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SightingInfo) Reset() {
@@ -116,20 +315,89 @@ func (x *SightingInfo) GetDurationSeconds() *wrapperspb.Int32Value {
 	return nil
 }
 
+func (x *SightingInfo) GetNormalizedColor() Color {
+	if x != nil {
+		return x.NormalizedColor
+	}
+	return Color_COLOR_UNSPECIFIED
+}
+
+func (x *SightingInfo) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+// Coordinates географические координаты в градусах WGS 84
+type Coordinates struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// latitude широта
+	Latitude float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// longitude долгота
+	Longitude     float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Coordinates) Reset() {
+	*x = Coordinates{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Coordinates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coordinates) ProtoMessage() {}
+
+func (x *Coordinates) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coordinates.ProtoReflect.Descriptor instead.
+func (*Coordinates) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Coordinates) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Coordinates) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
 // SightingUpdateInfo информация о наблюдении НЛО для обновления (все поля опциональны)
 type SightingUpdateInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// observed_at время наблюдения НЛО (опционально)
+	// observed_at время наблюдения НЛО (опционально), не в будущем (проверяется сервером)
 	ObservedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=observed_at,json=observedAt,proto3" json:"observed_at,omitempty"`
 	// location место наблюдения (опционально)
 	Location *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
-	// description описание наблюдаемого объекта (опционально)
+	// description описание наблюдаемого объекта (опционально), до 1000 символов
 	Description *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	// color цвет объекта (опционально)
 	Color *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
 	// sound признак наличия звука (опционально)
 	Sound *wrapperspb.BoolValue `protobuf:"bytes,5,opt,name=sound,proto3" json:"sound,omitempty"`
-	// duration_seconds продолжительность наблюдения в секундах (опционально)
+	// duration_seconds продолжительность наблюдения в секундах, от 1 до 86400 (опционально)
 	DurationSeconds *wrapperspb.Int32Value `protobuf:"bytes,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
@@ -137,7 +405,7 @@ type SightingUpdateInfo struct {
 
 func (x *SightingUpdateInfo) Reset() {
 	*x = SightingUpdateInfo{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[1]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -149,7 +417,7 @@ func (x *SightingUpdateInfo) String() string {
 func (*SightingUpdateInfo) ProtoMessage() {}
 
 func (x *SightingUpdateInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[1]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -162,7 +430,7 @@ func (x *SightingUpdateInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SightingUpdateInfo.ProtoReflect.Descriptor instead.
 func (*SightingUpdateInfo) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{1}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *SightingUpdateInfo) GetObservedAt() *timestamppb.Timestamp {
@@ -226,7 +494,7 @@ type Sighting struct {
 
 func (x *Sighting) Reset() {
 	*x = Sighting{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[2]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -238,7 +506,7 @@ func (x *Sighting) String() string {
 func (*Sighting) ProtoMessage() {}
 
 func (x *Sighting) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[2]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -251,7 +519,7 @@ func (x *Sighting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Sighting.ProtoReflect.Descriptor instead.
 func (*Sighting) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{2}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Sighting) GetUuid() string {
@@ -300,7 +568,7 @@ type CreateRequest struct {
 
 func (x *CreateRequest) Reset() {
 	*x = CreateRequest{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[3]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -312,7 +580,7 @@ func (x *CreateRequest) String() string {
 func (*CreateRequest) ProtoMessage() {}
 
 func (x *CreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[3]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -325,7 +593,7 @@ func (x *CreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{3}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateRequest) GetInfo() *SightingInfo {
@@ -346,7 +614,7 @@ type CreateResponse struct {
 
 func (x *CreateResponse) Reset() {
 	*x = CreateResponse{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[4]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -358,7 +626,7 @@ func (x *CreateResponse) String() string {
 func (*CreateResponse) ProtoMessage() {}
 
 func (x *CreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[4]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -371,7 +639,7 @@ func (x *CreateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{4}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateResponse) GetUuid() string {
@@ -381,15 +649,22 @@ func (x *CreateResponse) GetUuid() string {
 	return ""
 }
 
+// GetAllRequest запрос на получение всех наблюдений
 type GetAllRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter выражение CEL над полями Sighting в стиле AIP-160, например
+	// info.duration_seconds > 300 && info.color == "green" && !has(deleted_at).
+	// Наблюдение, для которого выражение не вычисляется (например, сравнение
+	// незаданного info.duration_seconds), в результат не попадает.
+	// Пустая строка возвращает все наблюдения.
+	Filter        string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetAllRequest) Reset() {
 	*x = GetAllRequest{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[5]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -401,7 +676,7 @@ func (x *GetAllRequest) String() string {
 func (*GetAllRequest) ProtoMessage() {}
 
 func (x *GetAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[5]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -414,20 +689,30 @@ func (x *GetAllRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllRequest.ProtoReflect.Descriptor instead.
 func (*GetAllRequest) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{5}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetAllRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
 }
 
+// GetAllResponse ответ со списком наблюдений
 type GetAllResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sightings     []*Sighting            `protobuf:"bytes,1,rep,name=sightings,proto3" json:"sightings,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sightings список наблюдений
+	Sightings []*Sighting `protobuf:"bytes,1,rep,name=sightings,proto3" json:"sightings,omitempty"`
+	// total_count общее количество наблюдений
+	TotalCount    int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetAllResponse) Reset() {
 	*x = GetAllResponse{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[6]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -439,7 +724,7 @@ func (x *GetAllResponse) String() string {
 func (*GetAllResponse) ProtoMessage() {}
 
 func (x *GetAllResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[6]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,7 +737,7 @@ func (x *GetAllResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllResponse.ProtoReflect.Descriptor instead.
 func (*GetAllResponse) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{6}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetAllResponse) GetSightings() []*Sighting {
@@ -480,7 +765,7 @@ type GetRequest struct {
 
 func (x *GetRequest) Reset() {
 	*x = GetRequest{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[7]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -492,7 +777,7 @@ func (x *GetRequest) String() string {
 func (*GetRequest) ProtoMessage() {}
 
 func (x *GetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[7]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -505,7 +790,7 @@ func (x *GetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{7}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetRequest) GetUuid() string {
@@ -526,7 +811,7 @@ type GetResponse struct {
 
 func (x *GetResponse) Reset() {
 	*x = GetResponse{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[8]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -538,7 +823,7 @@ func (x *GetResponse) String() string {
 func (*GetResponse) ProtoMessage() {}
 
 func (x *GetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[8]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -551,7 +836,7 @@ func (x *GetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
 func (*GetResponse) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{8}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetResponse) GetSighting() *Sighting {
@@ -574,7 +859,7 @@ type UpdateRequest struct {
 
 func (x *UpdateRequest) Reset() {
 	*x = UpdateRequest{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[9]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -586,7 +871,7 @@ func (x *UpdateRequest) String() string {
 func (*UpdateRequest) ProtoMessage() {}
 
 func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[9]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -599,7 +884,7 @@ func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
 func (*UpdateRequest) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{9}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateRequest) GetUuid() string {
@@ -627,7 +912,7 @@ type DeleteRequest struct {
 
 func (x *DeleteRequest) Reset() {
 	*x = DeleteRequest{}
-	mi := &file_ufo_v1_ufo_proto_msgTypes[10]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -639,7 +924,7 @@ func (x *DeleteRequest) String() string {
 func (*DeleteRequest) ProtoMessage() {}
 
 func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ufo_v1_ufo_proto_msgTypes[10]
+	mi := &file_ufo_v1_ufo_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,7 +937,7 @@ func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{10}
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DeleteRequest) GetUuid() string {
@@ -662,63 +947,775 @@ func (x *DeleteRequest) GetUuid() string {
 	return ""
 }
 
-var File_ufo_v1_ufo_proto protoreflect.FileDescriptor
+// SubscriptionFilter отбор наблюдений для подписки, пустые поля не ограничивают
+type SubscriptionFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// location_contains подстрока места наблюдения без учета регистра
+	LocationContains string `protobuf:"bytes,1,opt,name=location_contains,json=locationContains,proto3" json:"location_contains,omitempty"`
+	// colors допустимые цвета объекта: совпадение без учета регистра или по
+	// палитре Color ("red" подходит и для "#ff0000", и для "красный")
+	Colors        []string `protobuf:"bytes,2,rep,name=colors,proto3" json:"colors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_ufo_v1_ufo_proto_rawDesc = "" +
-	"\n" +
-	"\x10ufo/v1/ufo.proto\x12\x06ufo.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"\xc2\x02\n" +
-	"\fSightingInfo\x12;\n" +
-	"\vobserved_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"observedAt\x12%\n" +
-	"\blocation\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\blocation\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x122\n" +
-	"\x05color\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x05color\x120\n" +
-	"\x05sound\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x05sound\x12F\n" +
-	"\x10duration_seconds\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fdurationSeconds\"\xf9\x02\n" +
-	"\x12SightingUpdateInfo\x12;\n" +
-	"\vobserved_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"observedAt\x128\n" +
-	"\blocation\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\blocation\x12>\n" +
-	"\vdescription\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\vdescription\x122\n" +
-	"\x05color\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x05color\x120\n" +
-	"\x05sound\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x05sound\x12F\n" +
-	"\x10duration_seconds\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fdurationSeconds\"\xf9\x01\n" +
-	"\bSighting\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12(\n" +
-	"\x04info\x18\x02 \x01(\v2\x14.ufo.v1.SightingInfoR\x04info\x129\n" +
-	"\n" +
-	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
-	"\n" +
-	"deleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"9\n" +
-	"\rCreateRequest\x12(\n" +
-	"\x04info\x18\x01 \x01(\v2\x14.ufo.v1.SightingInfoR\x04info\"$\n" +
-	"\x0eCreateResponse\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"\x0f\n" +
-	"\rGetAllRequest\"a\n" +
-	"\x0eGetAllResponse\x12.\n" +
-	"\tsightings\x18\x01 \x03(\v2\x10.ufo.v1.SightingR\tsightings\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\" \n" +
-	"\n" +
-	"GetRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\";\n" +
-	"\vGetResponse\x12,\n" +
-	"\bsighting\x18\x01 \x01(\v2\x10.ufo.v1.SightingR\bsighting\"`\n" +
-	"\rUpdateRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12;\n" +
-	"\vupdate_info\x18\x02 \x01(\v2\x1a.ufo.v1.SightingUpdateInfoR\n" +
-	"updateInfo\"#\n" +
-	"\rDeleteRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid2\xa4\x03\n" +
+func (x *SubscriptionFilter) Reset() {
+	*x = SubscriptionFilter{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionFilter) ProtoMessage() {}
+
+func (x *SubscriptionFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionFilter.ProtoReflect.Descriptor instead.
+func (*SubscriptionFilter) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SubscriptionFilter) GetLocationContains() string {
+	if x != nil {
+		return x.LocationContains
+	}
+	return ""
+}
+
+func (x *SubscriptionFilter) GetColors() []string {
+	if x != nil {
+		return x.Colors
+	}
+	return nil
+}
+
+// Subscription подписка на webhook уведомления
+type Subscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id идентификатор подписки
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// url адрес, на который отправляются POST запросы с событиями
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// event_types типы событий, на которые оформлена подписка
+	EventTypes []EventType `protobuf:"varint,3,rep,packed,name=event_types,json=eventTypes,proto3,enum=ufo.v1.EventType" json:"event_types,omitempty"`
+	// filter отбор наблюдений (опционально)
+	Filter *SubscriptionFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// created_at время создания подписки
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Subscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Subscription) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Subscription) GetEventTypes() []EventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Subscription) GetFilter() *SubscriptionFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *Subscription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CreateSubscriptionRequest запрос на создание подписки
+type CreateSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// url адрес получателя (http или https)
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// event_types типы событий, хотя бы один
+	EventTypes []EventType `protobuf:"varint,2,rep,packed,name=event_types,json=eventTypes,proto3,enum=ufo.v1.EventType" json:"event_types,omitempty"`
+	// filter отбор наблюдений (опционально)
+	Filter *SubscriptionFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// secret ключ HMAC подписи, если пустой — генерируется сервером
+	Secret        string `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSubscriptionRequest) Reset() {
+	*x = CreateSubscriptionRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateSubscriptionRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetEventTypes() []EventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *CreateSubscriptionRequest) GetFilter() *SubscriptionFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *CreateSubscriptionRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// CreateSubscriptionResponse ответ на создание подписки
+type CreateSubscriptionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// subscription созданная подписка
+	Subscription *Subscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// secret ключ HMAC подписи, возвращается только при создании
+	Secret        string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSubscriptionResponse) Reset() {
+	*x = CreateSubscriptionResponse{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubscriptionResponse) ProtoMessage() {}
+
+func (x *CreateSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateSubscriptionResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *CreateSubscriptionResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// ListSubscriptionsRequest запрос на получение подписок
+type ListSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsRequest) Reset() {
+	*x = ListSubscriptionsRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{16}
+}
+
+// ListSubscriptionsResponse ответ со списком подписок
+type ListSubscriptionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// subscriptions список подписок
+	Subscriptions []*Subscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsResponse) Reset() {
+	*x = ListSubscriptionsResponse{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListSubscriptionsResponse) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+// DeleteSubscriptionRequest запрос на удаление подписки
+type DeleteSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id идентификатор подписки
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSubscriptionRequest) Reset() {
+	*x = DeleteSubscriptionRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Delivery доставка одного события одной подписке
+type Delivery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id идентификатор доставки, передается в заголовке X-UFO-Delivery
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// subscription_id идентификатор подписки
+	SubscriptionId string `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// event_type тип события
+	EventType EventType `protobuf:"varint,3,opt,name=event_type,json=eventType,proto3,enum=ufo.v1.EventType" json:"event_type,omitempty"`
+	// sighting_uuid идентификатор наблюдения
+	SightingUuid string `protobuf:"bytes,4,opt,name=sighting_uuid,json=sightingUuid,proto3" json:"sighting_uuid,omitempty"`
+	// status состояние доставки
+	Status DeliveryStatus `protobuf:"varint,5,opt,name=status,proto3,enum=ufo.v1.DeliveryStatus" json:"status,omitempty"`
+	// attempts количество выполненных попыток
+	Attempts int32 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// last_status_code HTTP код последнего ответа (0, если ответа не было)
+	LastStatusCode int32 `protobuf:"varint,7,opt,name=last_status_code,json=lastStatusCode,proto3" json:"last_status_code,omitempty"`
+	// last_error ошибка последней попытки
+	LastError string `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// created_at время постановки в очередь
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at время последнего изменения состояния
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Delivery) Reset() {
+	*x = Delivery{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Delivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Delivery) ProtoMessage() {}
+
+func (x *Delivery) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Delivery.ProtoReflect.Descriptor instead.
+func (*Delivery) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Delivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Delivery) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *Delivery) GetEventType() EventType {
+	if x != nil {
+		return x.EventType
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *Delivery) GetSightingUuid() string {
+	if x != nil {
+		return x.SightingUuid
+	}
+	return ""
+}
+
+func (x *Delivery) GetStatus() DeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *Delivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Delivery) GetLastStatusCode() int32 {
+	if x != nil {
+		return x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *Delivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Delivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Delivery) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ListDeliveriesRequest запрос истории доставок
+type ListDeliveriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// subscription_id отбор по подписке (опционально)
+	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// status отбор по состоянию (опционально), DELIVERY_STATUS_DEAD_LETTER возвращает dead letter список
+	Status        DeliveryStatus `protobuf:"varint,2,opt,name=status,proto3,enum=ufo.v1.DeliveryStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeliveriesRequest) Reset() {
+	*x = ListDeliveriesRequest{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListDeliveriesRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *ListDeliveriesRequest) GetStatus() DeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED
+}
+
+// ListDeliveriesResponse ответ с доставками, новые первыми
+type ListDeliveriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// deliveries список доставок
+	Deliveries    []*Delivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeliveriesResponse) Reset() {
+	*x = ListDeliveriesResponse{}
+	mi := &file_ufo_v1_ufo_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v1_ufo_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_ufo_v1_ufo_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListDeliveriesResponse) GetDeliveries() []*Delivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+var File_ufo_v1_ufo_proto protoreflect.FileDescriptor
+
+const file_ufo_v1_ufo_proto_rawDesc = "" +
+	"\n" +
+	"\x10ufo/v1/ufo.proto\x12\x06ufo.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"\xdf\x03\n" +
+	"\fSightingInfo\x12;\n" +
+	"\vobserved_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"observedAt\x12%\n" +
+	"\blocation\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\blocation\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xe8\aR\vdescription\x12=\n" +
+	"\x05color\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueB\t\xfaB\x06r\x04\x10\x01\x18 R\x05color\x120\n" +
+	"\x05sound\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x05sound\x12S\n" +
+	"\x10duration_seconds\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueB\v\xfaB\b\x1a\x06\x18\x80\xa3\x05 \x00R\x0fdurationSeconds\x12B\n" +
+	"\x10normalized_color\x18\a \x01(\x0e2\r.ufo.v1.ColorB\b\xfaB\x05\x82\x01\x02\x10\x01R\x0fnormalizedColor\x125\n" +
+	"\vcoordinates\x18\b \x01(\v2\x13.ufo.v1.CoordinatesR\vcoordinates\"y\n" +
+	"\vCoordinates\x123\n" +
+	"\blatitude\x18\x01 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80V@)\x00\x00\x00\x00\x00\x80V\xc0R\blatitude\x125\n" +
+	"\tlongitude\x18\x02 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80f@)\x00\x00\x00\x00\x00\x80f\xc0R\tlongitude\"\xa6\x03\n" +
+	"\x12SightingUpdateInfo\x12;\n" +
+	"\vobserved_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"observedAt\x12C\n" +
+	"\blocation\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueB\t\xfaB\x06r\x04\x10\x01\x182R\blocation\x12H\n" +
+	"\vdescription\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueB\b\xfaB\x05r\x03\x18\xe8\aR\vdescription\x12=\n" +
+	"\x05color\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueB\t\xfaB\x06r\x04\x10\x01\x18 R\x05color\x120\n" +
+	"\x05sound\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x05sound\x12S\n" +
+	"\x10duration_seconds\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueB\v\xfaB\b\x1a\x06\x18\x80\xa3\x05 \x00R\x0fdurationSeconds\"\xf9\x01\n" +
+	"\bSighting\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12(\n" +
+	"\x04info\x18\x02 \x01(\v2\x14.ufo.v1.SightingInfoR\x04info\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"C\n" +
+	"\rCreateRequest\x122\n" +
+	"\x04info\x18\x01 \x01(\v2\x14.ufo.v1.SightingInfoB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x04info\"$\n" +
+	"\x0eCreateResponse\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"1\n" +
+	"\rGetAllRequest\x12 \n" +
+	"\x06filter\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\x06filter\"a\n" +
+	"\x0eGetAllResponse\x12.\n" +
+	"\tsightings\x18\x01 \x03(\v2\x10.ufo.v1.SightingR\tsightings\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"*\n" +
+	"\n" +
+	"GetRequest\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\";\n" +
+	"\vGetResponse\x12,\n" +
+	"\bsighting\x18\x01 \x01(\v2\x10.ufo.v1.SightingR\bsighting\"t\n" +
+	"\rUpdateRequest\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\x12E\n" +
+	"\vupdate_info\x18\x02 \x01(\v2\x1a.ufo.v1.SightingUpdateInfoB\b\xfaB\x05\x8a\x01\x02\x10\x01R\n" +
+	"updateInfo\"-\n" +
+	"\rDeleteRequest\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\"t\n" +
+	"\x12SubscriptionFilter\x124\n" +
+	"\x11location_contains\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x182R\x10locationContains\x12(\n" +
+	"\x06colors\x18\x02 \x03(\tB\x10\xfaB\r\x92\x01\n" +
+	"\x10\x10\"\x06r\x04\x10\x01\x182R\x06colors\"\xd3\x01\n" +
+	"\fSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x122\n" +
+	"\vevent_types\x18\x03 \x03(\x0e2\x11.ufo.v1.EventTypeR\n" +
+	"eventTypes\x122\n" +
+	"\x06filter\x18\x04 \x01(\v2\x1a.ufo.v1.SubscriptionFilterR\x06filter\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd7\x01\n" +
+	"\x19CreateSubscriptionRequest\x12\x1d\n" +
+	"\x03url\x18\x01 \x01(\tB\v\xfaB\br\x06\x18\x80\x10\x88\x01\x01R\x03url\x12E\n" +
+	"\vevent_types\x18\x02 \x03(\x0e2\x11.ufo.v1.EventTypeB\x11\xfaB\x0e\x92\x01\v\b\x01\"\a\x82\x01\x04\x10\x01 \x00R\n" +
+	"eventTypes\x122\n" +
+	"\x06filter\x18\x03 \x01(\v2\x1a.ufo.v1.SubscriptionFilterR\x06filter\x12 \n" +
+	"\x06secret\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x06secret\"n\n" +
+	"\x1aCreateSubscriptionResponse\x128\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x14.ufo.v1.SubscriptionR\fsubscription\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"\x1a\n" +
+	"\x18ListSubscriptionsRequest\"W\n" +
+	"\x19ListSubscriptionsResponse\x12:\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x14.ufo.v1.SubscriptionR\rsubscriptions\"5\n" +
+	"\x19DeleteSubscriptionRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\"\xa5\x03\n" +
+	"\bDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\tR\x0esubscriptionId\x120\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\x0e2\x11.ufo.v1.EventTypeR\teventType\x12#\n" +
+	"\rsighting_uuid\x18\x04 \x01(\tR\fsightingUuid\x12.\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x16.ufo.v1.DeliveryStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12(\n" +
+	"\x10last_status_code\x18\a \x01(\x05R\x0elastStatusCode\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x87\x01\n" +
+	"\x15ListDeliveriesRequest\x124\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\x0esubscriptionId\x128\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x16.ufo.v1.DeliveryStatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06status\"J\n" +
+	"\x16ListDeliveriesResponse\x120\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x10.ufo.v1.DeliveryR\n" +
+	"deliveries*\xe7\x01\n" +
+	"\x05Color\x12\x15\n" +
+	"\x11COLOR_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tCOLOR_RED\x10\x01\x12\x10\n" +
+	"\fCOLOR_ORANGE\x10\x02\x12\x10\n" +
+	"\fCOLOR_YELLOW\x10\x03\x12\x0f\n" +
+	"\vCOLOR_GREEN\x10\x04\x12\x0e\n" +
+	"\n" +
+	"COLOR_CYAN\x10\x05\x12\x0e\n" +
+	"\n" +
+	"COLOR_BLUE\x10\x06\x12\x10\n" +
+	"\fCOLOR_PURPLE\x10\a\x12\x0e\n" +
+	"\n" +
+	"COLOR_PINK\x10\b\x12\x0f\n" +
+	"\vCOLOR_BROWN\x10\t\x12\x0f\n" +
+	"\vCOLOR_WHITE\x10\n" +
+	"\x12\x0e\n" +
+	"\n" +
+	"COLOR_GRAY\x10\v\x12\x0f\n" +
+	"\vCOLOR_BLACK\x10\f*\x8a\x01\n" +
+	"\tEventType\x12\x1a\n" +
+	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bEVENT_TYPE_SIGHTING_CREATED\x10\x01\x12\x1f\n" +
+	"\x1bEVENT_TYPE_SIGHTING_UPDATED\x10\x02\x12\x1f\n" +
+	"\x1bEVENT_TYPE_SIGHTING_DELETED\x10\x03*\x8e\x01\n" +
+	"\x0eDeliveryStatus\x12\x1f\n" +
+	"\x1bDELIVERY_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17DELIVERY_STATUS_PENDING\x10\x01\x12\x1d\n" +
+	"\x19DELIVERY_STATUS_SUCCEEDED\x10\x02\x12\x1f\n" +
+	"\x1bDELIVERY_STATUS_DEAD_LETTER\x10\x032\xe4\a\n" +
 	"\n" +
-	"UFOService\x12O\n" +
-	"\x06Create\x12\x15.ufo.v1.CreateRequest\x1a\x16.ufo.v1.CreateResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/api/v1/ufo\x12J\n" +
-	"\x03Get\x12\x12.ufo.v1.GetRequest\x1a\x13.ufo.v1.GetResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/ufo/{uuid}\x12V\n" +
-	"\x06Update\x12\x15.ufo.v1.UpdateRequest\x1a\x16.google.protobuf.Empty\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*2\x12/api/v1/ufo/{uuid}\x12S\n" +
-	"\x06Delete\x12\x15.ufo.v1.DeleteRequest\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/api/v1/ufo/{uuid}\x12L\n" +
-	"\x06GetAll\x12\x15.ufo.v1.GetAllRequest\x1a\x16.ufo.v1.GetAllResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/api/v1/ufoBVZTgithub.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1;ufo_v1b\x06proto3"
+	"UFOService\x12R\n" +
+	"\x06Create\x12\x15.ufo.v1.CreateRequest\x1a\x16.ufo.v1.CreateResponse\"\x19\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/api/v1/ufo\x88\x02\x01\x12M\n" +
+	"\x03Get\x12\x12.ufo.v1.GetRequest\x1a\x13.ufo.v1.GetResponse\"\x1d\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/ufo/{uuid}\x88\x02\x01\x12Y\n" +
+	"\x06Update\x12\x15.ufo.v1.UpdateRequest\x1a\x16.google.protobuf.Empty\" \x82\xd3\xe4\x93\x02\x17:\x01*2\x12/api/v1/ufo/{uuid}\x88\x02\x01\x12V\n" +
+	"\x06Delete\x12\x15.ufo.v1.DeleteRequest\x1a\x16.google.protobuf.Empty\"\x1d\x82\xd3\xe4\x93\x02\x14*\x12/api/v1/ufo/{uuid}\x88\x02\x01\x12O\n" +
+	"\x06GetAll\x12\x15.ufo.v1.GetAllRequest\x1a\x16.ufo.v1.GetAllResponse\"\x16\x82\xd3\xe4\x93\x02\r\x12\v/api/v1/ufo\x88\x02\x01\x12U\n" +
+	"\tStreamAll\x12\x15.ufo.v1.GetAllRequest\x1a\x10.ufo.v1.Sighting\"\x1d\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/ufo:stream\x88\x02\x010\x01\x12}\n" +
+	"\x12CreateSubscription\x12!.ufo.v1.CreateSubscriptionRequest\x1a\".ufo.v1.CreateSubscriptionResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/subscriptions\x12w\n" +
+	"\x11ListSubscriptions\x12 .ufo.v1.ListSubscriptionsRequest\x1a!.ufo.v1.ListSubscriptionsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/subscriptions\x12s\n" +
+	"\x12DeleteSubscription\x12!.ufo.v1.DeleteSubscriptionRequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/api/v1/subscriptions/{id}\x12k\n" +
+	"\x0eListDeliveries\x12\x1d.ufo.v1.ListDeliveriesRequest\x1a\x1e.ufo.v1.ListDeliveriesResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/deliveriesBVZTgithub.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1;ufo_v1b\x06proto3"
 
 var (
 	file_ufo_v1_ufo_proto_rawDescOnce sync.Once
@@ -732,59 +1729,99 @@ func file_ufo_v1_ufo_proto_rawDescGZIP() []byte {
 	return file_ufo_v1_ufo_proto_rawDescData
 }
 
-var file_ufo_v1_ufo_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_ufo_v1_ufo_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_ufo_v1_ufo_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_ufo_v1_ufo_proto_goTypes = []any{
-	(*SightingInfo)(nil),           // 0: ufo.v1.SightingInfo
-	(*SightingUpdateInfo)(nil),     // 1: ufo.v1.SightingUpdateInfo
-	(*Sighting)(nil),               // 2: ufo.v1.Sighting
-	(*CreateRequest)(nil),          // 3: ufo.v1.CreateRequest
-	(*CreateResponse)(nil),         // 4: ufo.v1.CreateResponse
-	(*GetAllRequest)(nil),          // 5: ufo.v1.GetAllRequest
-	(*GetAllResponse)(nil),         // 6: ufo.v1.GetAllResponse
-	(*GetRequest)(nil),             // 7: ufo.v1.GetRequest
-	(*GetResponse)(nil),            // 8: ufo.v1.GetResponse
-	(*UpdateRequest)(nil),          // 9: ufo.v1.UpdateRequest
-	(*DeleteRequest)(nil),          // 10: ufo.v1.DeleteRequest
-	(*timestamppb.Timestamp)(nil),  // 11: google.protobuf.Timestamp
-	(*wrapperspb.StringValue)(nil), // 12: google.protobuf.StringValue
-	(*wrapperspb.BoolValue)(nil),   // 13: google.protobuf.BoolValue
-	(*wrapperspb.Int32Value)(nil),  // 14: google.protobuf.Int32Value
-	(*emptypb.Empty)(nil),          // 15: google.protobuf.Empty
+	(Color)(0),                         // 0: ufo.v1.Color
+	(EventType)(0),                     // 1: ufo.v1.EventType
+	(DeliveryStatus)(0),                // 2: ufo.v1.DeliveryStatus
+	(*SightingInfo)(nil),               // 3: ufo.v1.SightingInfo
+	(*Coordinates)(nil),                // 4: ufo.v1.Coordinates
+	(*SightingUpdateInfo)(nil),         // 5: ufo.v1.SightingUpdateInfo
+	(*Sighting)(nil),                   // 6: ufo.v1.Sighting
+	(*CreateRequest)(nil),              // 7: ufo.v1.CreateRequest
+	(*CreateResponse)(nil),             // 8: ufo.v1.CreateResponse
+	(*GetAllRequest)(nil),              // 9: ufo.v1.GetAllRequest
+	(*GetAllResponse)(nil),             // 10: ufo.v1.GetAllResponse
+	(*GetRequest)(nil),                 // 11: ufo.v1.GetRequest
+	(*GetResponse)(nil),                // 12: ufo.v1.GetResponse
+	(*UpdateRequest)(nil),              // 13: ufo.v1.UpdateRequest
+	(*DeleteRequest)(nil),              // 14: ufo.v1.DeleteRequest
+	(*SubscriptionFilter)(nil),         // 15: ufo.v1.SubscriptionFilter
+	(*Subscription)(nil),               // 16: ufo.v1.Subscription
+	(*CreateSubscriptionRequest)(nil),  // 17: ufo.v1.CreateSubscriptionRequest
+	(*CreateSubscriptionResponse)(nil), // 18: ufo.v1.CreateSubscriptionResponse
+	(*ListSubscriptionsRequest)(nil),   // 19: ufo.v1.ListSubscriptionsRequest
+	(*ListSubscriptionsResponse)(nil),  // 20: ufo.v1.ListSubscriptionsResponse
+	(*DeleteSubscriptionRequest)(nil),  // 21: ufo.v1.DeleteSubscriptionRequest
+	(*Delivery)(nil),                   // 22: ufo.v1.Delivery
+	(*ListDeliveriesRequest)(nil),      // 23: ufo.v1.ListDeliveriesRequest
+	(*ListDeliveriesResponse)(nil),     // 24: ufo.v1.ListDeliveriesResponse
+	(*timestamppb.Timestamp)(nil),      // 25: google.protobuf.Timestamp
+	(*wrapperspb.StringValue)(nil),     // 26: google.protobuf.StringValue
+	(*wrapperspb.BoolValue)(nil),       // 27: google.protobuf.BoolValue
+	(*wrapperspb.Int32Value)(nil),      // 28: google.protobuf.Int32Value
+	(*emptypb.Empty)(nil),              // 29: google.protobuf.Empty
 }
 var file_ufo_v1_ufo_proto_depIdxs = []int32{
-	11, // 0: ufo.v1.SightingInfo.observed_at:type_name -> google.protobuf.Timestamp
-	12, // 1: ufo.v1.SightingInfo.color:type_name -> google.protobuf.StringValue
-	13, // 2: ufo.v1.SightingInfo.sound:type_name -> google.protobuf.BoolValue
-	14, // 3: ufo.v1.SightingInfo.duration_seconds:type_name -> google.protobuf.Int32Value
-	11, // 4: ufo.v1.SightingUpdateInfo.observed_at:type_name -> google.protobuf.Timestamp
-	12, // 5: ufo.v1.SightingUpdateInfo.location:type_name -> google.protobuf.StringValue
-	12, // 6: ufo.v1.SightingUpdateInfo.description:type_name -> google.protobuf.StringValue
-	12, // 7: ufo.v1.SightingUpdateInfo.color:type_name -> google.protobuf.StringValue
-	13, // 8: ufo.v1.SightingUpdateInfo.sound:type_name -> google.protobuf.BoolValue
-	14, // 9: ufo.v1.SightingUpdateInfo.duration_seconds:type_name -> google.protobuf.Int32Value
-	0,  // 10: ufo.v1.Sighting.info:type_name -> ufo.v1.SightingInfo
-	11, // 11: ufo.v1.Sighting.created_at:type_name -> google.protobuf.Timestamp
-	11, // 12: ufo.v1.Sighting.updated_at:type_name -> google.protobuf.Timestamp
-	11, // 13: ufo.v1.Sighting.deleted_at:type_name -> google.protobuf.Timestamp
-	0,  // 14: ufo.v1.CreateRequest.info:type_name -> ufo.v1.SightingInfo
-	2,  // 15: ufo.v1.GetAllResponse.sightings:type_name -> ufo.v1.Sighting
-	2,  // 16: ufo.v1.GetResponse.sighting:type_name -> ufo.v1.Sighting
-	1,  // 17: ufo.v1.UpdateRequest.update_info:type_name -> ufo.v1.SightingUpdateInfo
-	3,  // 18: ufo.v1.UFOService.Create:input_type -> ufo.v1.CreateRequest
-	7,  // 19: ufo.v1.UFOService.Get:input_type -> ufo.v1.GetRequest
-	9,  // 20: ufo.v1.UFOService.Update:input_type -> ufo.v1.UpdateRequest
-	10, // 21: ufo.v1.UFOService.Delete:input_type -> ufo.v1.DeleteRequest
-	5,  // 22: ufo.v1.UFOService.GetAll:input_type -> ufo.v1.GetAllRequest
-	4,  // 23: ufo.v1.UFOService.Create:output_type -> ufo.v1.CreateResponse
-	8,  // 24: ufo.v1.UFOService.Get:output_type -> ufo.v1.GetResponse
-	15, // 25: ufo.v1.UFOService.Update:output_type -> google.protobuf.Empty
-	15, // 26: ufo.v1.UFOService.Delete:output_type -> google.protobuf.Empty
-	6,  // 27: ufo.v1.UFOService.GetAll:output_type -> ufo.v1.GetAllResponse
-	23, // [23:28] is the sub-list for method output_type
-	18, // [18:23] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	25, // 0: ufo.v1.SightingInfo.observed_at:type_name -> google.protobuf.Timestamp
+	26, // 1: ufo.v1.SightingInfo.color:type_name -> google.protobuf.StringValue
+	27, // 2: ufo.v1.SightingInfo.sound:type_name -> google.protobuf.BoolValue
+	28, // 3: ufo.v1.SightingInfo.duration_seconds:type_name -> google.protobuf.Int32Value
+	0,  // 4: ufo.v1.SightingInfo.normalized_color:type_name -> ufo.v1.Color
+	4,  // 5: ufo.v1.SightingInfo.coordinates:type_name -> ufo.v1.Coordinates
+	25, // 6: ufo.v1.SightingUpdateInfo.observed_at:type_name -> google.protobuf.Timestamp
+	26, // 7: ufo.v1.SightingUpdateInfo.location:type_name -> google.protobuf.StringValue
+	26, // 8: ufo.v1.SightingUpdateInfo.description:type_name -> google.protobuf.StringValue
+	26, // 9: ufo.v1.SightingUpdateInfo.color:type_name -> google.protobuf.StringValue
+	27, // 10: ufo.v1.SightingUpdateInfo.sound:type_name -> google.protobuf.BoolValue
+	28, // 11: ufo.v1.SightingUpdateInfo.duration_seconds:type_name -> google.protobuf.Int32Value
+	3,  // 12: ufo.v1.Sighting.info:type_name -> ufo.v1.SightingInfo
+	25, // 13: ufo.v1.Sighting.created_at:type_name -> google.protobuf.Timestamp
+	25, // 14: ufo.v1.Sighting.updated_at:type_name -> google.protobuf.Timestamp
+	25, // 15: ufo.v1.Sighting.deleted_at:type_name -> google.protobuf.Timestamp
+	3,  // 16: ufo.v1.CreateRequest.info:type_name -> ufo.v1.SightingInfo
+	6,  // 17: ufo.v1.GetAllResponse.sightings:type_name -> ufo.v1.Sighting
+	6,  // 18: ufo.v1.GetResponse.sighting:type_name -> ufo.v1.Sighting
+	5,  // 19: ufo.v1.UpdateRequest.update_info:type_name -> ufo.v1.SightingUpdateInfo
+	1,  // 20: ufo.v1.Subscription.event_types:type_name -> ufo.v1.EventType
+	15, // 21: ufo.v1.Subscription.filter:type_name -> ufo.v1.SubscriptionFilter
+	25, // 22: ufo.v1.Subscription.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 23: ufo.v1.CreateSubscriptionRequest.event_types:type_name -> ufo.v1.EventType
+	15, // 24: ufo.v1.CreateSubscriptionRequest.filter:type_name -> ufo.v1.SubscriptionFilter
+	16, // 25: ufo.v1.CreateSubscriptionResponse.subscription:type_name -> ufo.v1.Subscription
+	16, // 26: ufo.v1.ListSubscriptionsResponse.subscriptions:type_name -> ufo.v1.Subscription
+	1,  // 27: ufo.v1.Delivery.event_type:type_name -> ufo.v1.EventType
+	2,  // 28: ufo.v1.Delivery.status:type_name -> ufo.v1.DeliveryStatus
+	25, // 29: ufo.v1.Delivery.created_at:type_name -> google.protobuf.Timestamp
+	25, // 30: ufo.v1.Delivery.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 31: ufo.v1.ListDeliveriesRequest.status:type_name -> ufo.v1.DeliveryStatus
+	22, // 32: ufo.v1.ListDeliveriesResponse.deliveries:type_name -> ufo.v1.Delivery
+	7,  // 33: ufo.v1.UFOService.Create:input_type -> ufo.v1.CreateRequest
+	11, // 34: ufo.v1.UFOService.Get:input_type -> ufo.v1.GetRequest
+	13, // 35: ufo.v1.UFOService.Update:input_type -> ufo.v1.UpdateRequest
+	14, // 36: ufo.v1.UFOService.Delete:input_type -> ufo.v1.DeleteRequest
+	9,  // 37: ufo.v1.UFOService.GetAll:input_type -> ufo.v1.GetAllRequest
+	9,  // 38: ufo.v1.UFOService.StreamAll:input_type -> ufo.v1.GetAllRequest
+	17, // 39: ufo.v1.UFOService.CreateSubscription:input_type -> ufo.v1.CreateSubscriptionRequest
+	19, // 40: ufo.v1.UFOService.ListSubscriptions:input_type -> ufo.v1.ListSubscriptionsRequest
+	21, // 41: ufo.v1.UFOService.DeleteSubscription:input_type -> ufo.v1.DeleteSubscriptionRequest
+	23, // 42: ufo.v1.UFOService.ListDeliveries:input_type -> ufo.v1.ListDeliveriesRequest
+	8,  // 43: ufo.v1.UFOService.Create:output_type -> ufo.v1.CreateResponse
+	12, // 44: ufo.v1.UFOService.Get:output_type -> ufo.v1.GetResponse
+	29, // 45: ufo.v1.UFOService.Update:output_type -> google.protobuf.Empty
+	29, // 46: ufo.v1.UFOService.Delete:output_type -> google.protobuf.Empty
+	10, // 47: ufo.v1.UFOService.GetAll:output_type -> ufo.v1.GetAllResponse
+	6,  // 48: ufo.v1.UFOService.StreamAll:output_type -> ufo.v1.Sighting
+	18, // 49: ufo.v1.UFOService.CreateSubscription:output_type -> ufo.v1.CreateSubscriptionResponse
+	20, // 50: ufo.v1.UFOService.ListSubscriptions:output_type -> ufo.v1.ListSubscriptionsResponse
+	29, // 51: ufo.v1.UFOService.DeleteSubscription:output_type -> google.protobuf.Empty
+	24, // 52: ufo.v1.UFOService.ListDeliveries:output_type -> ufo.v1.ListDeliveriesResponse
+	43, // [43:53] is the sub-list for method output_type
+	33, // [33:43] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_ufo_v1_ufo_proto_init() }
@@ -797,13 +1834,14 @@ func file_ufo_v1_ufo_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ufo_v1_ufo_proto_rawDesc), len(file_ufo_v1_ufo_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      3,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_ufo_v1_ufo_proto_goTypes,
 		DependencyIndexes: file_ufo_v1_ufo_proto_depIdxs,
+		EnumInfos:         file_ufo_v1_ufo_proto_enumTypes,
 		MessageInfos:      file_ufo_v1_ufo_proto_msgTypes,
 	}.Build()
 	File_ufo_v1_ufo_proto = out.File
@@ -35,6 +35,9 @@ var (
 	_ = sort.Sort
 )
 
+// define the regex for a UUID once up-front
+var _ufo_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
 // Validate checks the field values on SightingInfo with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -97,35 +100,30 @@ func (m *SightingInfo) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Description
-
-	if all {
-		switch v := interface{}(m.GetColor()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SightingInfoValidationError{
-					field:  "Color",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SightingInfoValidationError{
-					field:  "Color",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetDescription()) > 1000 {
+		err := SightingInfoValidationError{
+			field:  "Description",
+			reason: "value length must be at most 1000 runes",
 		}
-	} else if v, ok := interface{}(m.GetColor()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SightingInfoValidationError{
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if wrapper := m.GetColor(); wrapper != nil {
+
+		if l := utf8.RuneCountInString(wrapper.GetValue()); l < 1 || l > 32 {
+			err := SightingInfoValidationError{
 				field:  "Color",
-				reason: "embedded message failed validation",
-				cause:  err,
+				reason: "value length must be between 1 and 32 runes, inclusive",
+			}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
+
 	}
 
 	if all {
@@ -157,12 +155,38 @@ func (m *SightingInfo) validate(all bool) error {
 		}
 	}
 
+	if wrapper := m.GetDurationSeconds(); wrapper != nil {
+
+		if val := wrapper.GetValue(); val <= 0 || val > 86400 {
+			err := SightingInfoValidationError{
+				field:  "DurationSeconds",
+				reason: "value must be inside range (0, 86400]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := Color_name[int32(m.GetNormalizedColor())]; !ok {
+		err := SightingInfoValidationError{
+			field:  "NormalizedColor",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if all {
-		switch v := interface{}(m.GetDurationSeconds()).(type) {
+		switch v := interface{}(m.GetCoordinates()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
 				errors = append(errors, SightingInfoValidationError{
-					field:  "DurationSeconds",
+					field:  "Coordinates",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
@@ -170,16 +194,16 @@ func (m *SightingInfo) validate(all bool) error {
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
 				errors = append(errors, SightingInfoValidationError{
-					field:  "DurationSeconds",
+					field:  "Coordinates",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDurationSeconds()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCoordinates()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
 			return SightingInfoValidationError{
-				field:  "DurationSeconds",
+				field:  "Coordinates",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -263,6 +287,127 @@ var _ interface {
 	ErrorName() string
 } = SightingInfoValidationError{}
 
+// Validate checks the field values on Coordinates with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Coordinates) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Coordinates with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CoordinatesMultiError, or
+// nil if none found.
+func (m *Coordinates) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Coordinates) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetLatitude(); val < -90 || val > 90 {
+		err := CoordinatesValidationError{
+			field:  "Latitude",
+			reason: "value must be inside range [-90, 90]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLongitude(); val < -180 || val > 180 {
+		err := CoordinatesValidationError{
+			field:  "Longitude",
+			reason: "value must be inside range [-180, 180]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CoordinatesMultiError(errors)
+	}
+
+	return nil
+}
+
+// CoordinatesMultiError is an error wrapping multiple validation errors
+// returned by Coordinates.ValidateAll() if the designated constraints aren't met.
+type CoordinatesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CoordinatesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CoordinatesMultiError) AllErrors() []error { return m }
+
+// CoordinatesValidationError is the validation error returned by
+// Coordinates.Validate if the designated constraints aren't met.
+type CoordinatesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CoordinatesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CoordinatesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CoordinatesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CoordinatesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CoordinatesValidationError) ErrorName() string { return "CoordinatesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CoordinatesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCoordinates.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CoordinatesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CoordinatesValidationError{}
+
 // Validate checks the field values on SightingUpdateInfo with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -314,91 +459,49 @@ func (m *SightingUpdateInfo) validate(all bool) error {
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetLocation()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "Location",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "Location",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SightingUpdateInfoValidationError{
+	if wrapper := m.GetLocation(); wrapper != nil {
+
+		if l := utf8.RuneCountInString(wrapper.GetValue()); l < 1 || l > 50 {
+			err := SightingUpdateInfoValidationError{
 				field:  "Location",
-				reason: "embedded message failed validation",
-				cause:  err,
+				reason: "value length must be between 1 and 50 runes, inclusive",
 			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
+
 	}
 
-	if all {
-		switch v := interface{}(m.GetDescription()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "Description",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "Description",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDescription()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SightingUpdateInfoValidationError{
+	if wrapper := m.GetDescription(); wrapper != nil {
+
+		if utf8.RuneCountInString(wrapper.GetValue()) > 1000 {
+			err := SightingUpdateInfoValidationError{
 				field:  "Description",
-				reason: "embedded message failed validation",
-				cause:  err,
+				reason: "value length must be at most 1000 runes",
+			}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
+
 	}
 
-	if all {
-		switch v := interface{}(m.GetColor()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "Color",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "Color",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetColor()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SightingUpdateInfoValidationError{
+	if wrapper := m.GetColor(); wrapper != nil {
+
+		if l := utf8.RuneCountInString(wrapper.GetValue()); l < 1 || l > 32 {
+			err := SightingUpdateInfoValidationError{
 				field:  "Color",
-				reason: "embedded message failed validation",
-				cause:  err,
+				reason: "value length must be between 1 and 32 runes, inclusive",
 			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
+
 	}
 
 	if all {
@@ -430,33 +533,19 @@ func (m *SightingUpdateInfo) validate(all bool) error {
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetDurationSeconds()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "DurationSeconds",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "DurationSeconds",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDurationSeconds()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SightingUpdateInfoValidationError{
+	if wrapper := m.GetDurationSeconds(); wrapper != nil {
+
+		if val := wrapper.GetValue(); val <= 0 || val > 86400 {
+			err := SightingUpdateInfoValidationError{
 				field:  "DurationSeconds",
-				reason: "embedded message failed validation",
-				cause:  err,
+				reason: "value must be inside range (0, 86400]",
+			}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
+
 	}
 
 	if len(errors) > 0 {
@@ -778,6 +867,17 @@ func (m *CreateRequest) validate(all bool) error {
 
 	var errors []error
 
+	if m.GetInfo() == nil {
+		err := CreateRequestValidationError{
+			field:  "Info",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if all {
 		switch v := interface{}(m.GetInfo()).(type) {
 		case interface{ ValidateAll() error }:
@@ -1009,6 +1109,17 @@ func (m *GetAllRequest) validate(all bool) error {
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetFilter()) > 1024 {
+		err := GetAllRequestValidationError{
+			field:  "Filter",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return GetAllRequestMultiError(errors)
 	}
@@ -1245,7 +1356,17 @@ func (m *GetRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Uuid
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = GetRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
 		return GetRequestMultiError(errors)
@@ -1254,6 +1375,14 @@ func (m *GetRequest) validate(all bool) error {
 	return nil
 }
 
+func (m *GetRequest) _validateUuid(uuid string) error {
+	if matched := _ufo_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
 // GetRequestMultiError is an error wrapping multiple validation errors
 // returned by GetRequest.ValidateAll() if the designated constraints aren't met.
 type GetRequestMultiError []error
@@ -1474,7 +1603,28 @@ func (m *UpdateRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Uuid
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = UpdateRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUpdateInfo() == nil {
+		err := UpdateRequestValidationError{
+			field:  "UpdateInfo",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if all {
 		switch v := interface{}(m.GetUpdateInfo()).(type) {
@@ -1512,6 +1662,14 @@ func (m *UpdateRequest) validate(all bool) error {
 	return nil
 }
 
+func (m *UpdateRequest) _validateUuid(uuid string) error {
+	if matched := _ufo_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
 // UpdateRequestMultiError is an error wrapping multiple validation errors
 // returned by UpdateRequest.ValidateAll() if the designated constraints
 // aren't met.
@@ -1605,7 +1763,17 @@ func (m *DeleteRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Uuid
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = DeleteRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
 		return DeleteRequestMultiError(errors)
@@ -1614,6 +1782,14 @@ func (m *DeleteRequest) validate(all bool) error {
 	return nil
 }
 
+func (m *DeleteRequest) _validateUuid(uuid string) error {
+	if matched := _ufo_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
 // DeleteRequestMultiError is an error wrapping multiple validation errors
 // returned by DeleteRequest.ValidateAll() if the designated constraints
 // aren't met.
@@ -1684,3 +1860,1459 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = DeleteRequestValidationError{}
+
+// Validate checks the field values on SubscriptionFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubscriptionFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubscriptionFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubscriptionFilterMultiError, or nil if none found.
+func (m *SubscriptionFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubscriptionFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetLocationContains()) > 50 {
+		err := SubscriptionFilterValidationError{
+			field:  "LocationContains",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetColors()) > 16 {
+		err := SubscriptionFilterValidationError{
+			field:  "Colors",
+			reason: "value must contain no more than 16 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetColors() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 50 {
+			err := SubscriptionFilterValidationError{
+				field:  fmt.Sprintf("Colors[%v]", idx),
+				reason: "value length must be between 1 and 50 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SubscriptionFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubscriptionFilterMultiError is an error wrapping multiple validation errors
+// returned by SubscriptionFilter.ValidateAll() if the designated constraints
+// aren't met.
+type SubscriptionFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubscriptionFilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubscriptionFilterMultiError) AllErrors() []error { return m }
+
+// SubscriptionFilterValidationError is the validation error returned by
+// SubscriptionFilter.Validate if the designated constraints aren't met.
+type SubscriptionFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubscriptionFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubscriptionFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubscriptionFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubscriptionFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubscriptionFilterValidationError) ErrorName() string {
+	return "SubscriptionFilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubscriptionFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubscriptionFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubscriptionFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubscriptionFilterValidationError{}
+
+// Validate checks the field values on Subscription with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Subscription) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Subscription with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SubscriptionMultiError, or
+// nil if none found.
+func (m *Subscription) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Subscription) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Url
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubscriptionValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubscriptionValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubscriptionValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubscriptionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubscriptionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubscriptionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SubscriptionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubscriptionMultiError is an error wrapping multiple validation errors
+// returned by Subscription.ValidateAll() if the designated constraints aren't met.
+type SubscriptionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubscriptionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubscriptionMultiError) AllErrors() []error { return m }
+
+// SubscriptionValidationError is the validation error returned by
+// Subscription.Validate if the designated constraints aren't met.
+type SubscriptionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubscriptionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubscriptionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubscriptionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubscriptionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubscriptionValidationError) ErrorName() string { return "SubscriptionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubscriptionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubscription.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubscriptionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubscriptionValidationError{}
+
+// Validate checks the field values on CreateSubscriptionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSubscriptionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSubscriptionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSubscriptionRequestMultiError, or nil if none found.
+func (m *CreateSubscriptionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSubscriptionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUrl()) > 2048 {
+		err := CreateSubscriptionRequestValidationError{
+			field:  "Url",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = CreateSubscriptionRequestValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := CreateSubscriptionRequestValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetEventTypes()) < 1 {
+		err := CreateSubscriptionRequestValidationError{
+			field:  "EventTypes",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetEventTypes() {
+		_, _ = idx, item
+
+		if _, ok := _CreateSubscriptionRequest_EventTypes_NotInLookup[item]; ok {
+			err := CreateSubscriptionRequestValidationError{
+				field:  fmt.Sprintf("EventTypes[%v]", idx),
+				reason: "value must not be in list [0]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if _, ok := EventType_name[int32(item)]; !ok {
+			err := CreateSubscriptionRequestValidationError{
+				field:  fmt.Sprintf("EventTypes[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSubscriptionRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSubscriptionRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSubscriptionRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetSecret()) > 256 {
+		err := CreateSubscriptionRequestValidationError{
+			field:  "Secret",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateSubscriptionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSubscriptionRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSubscriptionRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateSubscriptionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSubscriptionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSubscriptionRequestMultiError) AllErrors() []error { return m }
+
+// CreateSubscriptionRequestValidationError is the validation error returned by
+// CreateSubscriptionRequest.Validate if the designated constraints aren't met.
+type CreateSubscriptionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSubscriptionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSubscriptionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSubscriptionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSubscriptionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSubscriptionRequestValidationError) ErrorName() string {
+	return "CreateSubscriptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSubscriptionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSubscriptionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSubscriptionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSubscriptionRequestValidationError{}
+
+var _CreateSubscriptionRequest_EventTypes_NotInLookup = map[EventType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on CreateSubscriptionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSubscriptionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSubscriptionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSubscriptionResponseMultiError, or nil if none found.
+func (m *CreateSubscriptionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSubscriptionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSubscription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSubscriptionResponseValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSubscriptionResponseValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubscription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSubscriptionResponseValidationError{
+				field:  "Subscription",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Secret
+
+	if len(errors) > 0 {
+		return CreateSubscriptionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSubscriptionResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateSubscriptionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateSubscriptionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSubscriptionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSubscriptionResponseMultiError) AllErrors() []error { return m }
+
+// CreateSubscriptionResponseValidationError is the validation error returned
+// by CreateSubscriptionResponse.Validate if the designated constraints aren't met.
+type CreateSubscriptionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSubscriptionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSubscriptionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSubscriptionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSubscriptionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSubscriptionResponseValidationError) ErrorName() string {
+	return "CreateSubscriptionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSubscriptionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSubscriptionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSubscriptionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSubscriptionResponseValidationError{}
+
+// Validate checks the field values on ListSubscriptionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSubscriptionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSubscriptionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSubscriptionsRequestMultiError, or nil if none found.
+func (m *ListSubscriptionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSubscriptionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListSubscriptionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSubscriptionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSubscriptionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSubscriptionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSubscriptionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSubscriptionsRequestMultiError) AllErrors() []error { return m }
+
+// ListSubscriptionsRequestValidationError is the validation error returned by
+// ListSubscriptionsRequest.Validate if the designated constraints aren't met.
+type ListSubscriptionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSubscriptionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSubscriptionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSubscriptionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSubscriptionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSubscriptionsRequestValidationError) ErrorName() string {
+	return "ListSubscriptionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSubscriptionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSubscriptionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSubscriptionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSubscriptionsRequestValidationError{}
+
+// Validate checks the field values on ListSubscriptionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSubscriptionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSubscriptionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSubscriptionsResponseMultiError, or nil if none found.
+func (m *ListSubscriptionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSubscriptionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSubscriptions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSubscriptionsResponseValidationError{
+						field:  fmt.Sprintf("Subscriptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSubscriptionsResponseValidationError{
+						field:  fmt.Sprintf("Subscriptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSubscriptionsResponseValidationError{
+					field:  fmt.Sprintf("Subscriptions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSubscriptionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSubscriptionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSubscriptionsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListSubscriptionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSubscriptionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSubscriptionsResponseMultiError) AllErrors() []error { return m }
+
+// ListSubscriptionsResponseValidationError is the validation error returned by
+// ListSubscriptionsResponse.Validate if the designated constraints aren't met.
+type ListSubscriptionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSubscriptionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSubscriptionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSubscriptionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSubscriptionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSubscriptionsResponseValidationError) ErrorName() string {
+	return "ListSubscriptionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSubscriptionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSubscriptionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSubscriptionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSubscriptionsResponseValidationError{}
+
+// Validate checks the field values on DeleteSubscriptionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSubscriptionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSubscriptionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSubscriptionRequestMultiError, or nil if none found.
+func (m *DeleteSubscriptionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSubscriptionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = DeleteSubscriptionRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteSubscriptionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteSubscriptionRequest) _validateUuid(uuid string) error {
+	if matched := _ufo_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteSubscriptionRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSubscriptionRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteSubscriptionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSubscriptionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSubscriptionRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSubscriptionRequestValidationError is the validation error returned by
+// DeleteSubscriptionRequest.Validate if the designated constraints aren't met.
+type DeleteSubscriptionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSubscriptionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSubscriptionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSubscriptionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSubscriptionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSubscriptionRequestValidationError) ErrorName() string {
+	return "DeleteSubscriptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSubscriptionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSubscriptionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSubscriptionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSubscriptionRequestValidationError{}
+
+// Validate checks the field values on Delivery with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Delivery) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Delivery with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeliveryMultiError, or nil
+// if none found.
+func (m *Delivery) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Delivery) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for SubscriptionId
+
+	// no validation rules for EventType
+
+	// no validation rules for SightingUuid
+
+	// no validation rules for Status
+
+	// no validation rules for Attempts
+
+	// no validation rules for LastStatusCode
+
+	// no validation rules for LastError
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeliveryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeliveryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeliveryValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeliveryValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeliveryValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeliveryValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeliveryMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeliveryMultiError is an error wrapping multiple validation errors returned
+// by Delivery.ValidateAll() if the designated constraints aren't met.
+type DeliveryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeliveryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeliveryMultiError) AllErrors() []error { return m }
+
+// DeliveryValidationError is the validation error returned by
+// Delivery.Validate if the designated constraints aren't met.
+type DeliveryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeliveryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeliveryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeliveryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeliveryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeliveryValidationError) ErrorName() string { return "DeliveryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeliveryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDelivery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeliveryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeliveryValidationError{}
+
+// Validate checks the field values on ListDeliveriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeliveriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeliveriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeliveriesRequestMultiError, or nil if none found.
+func (m *ListDeliveriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeliveriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSubscriptionId() != "" {
+
+		if err := m._validateUuid(m.GetSubscriptionId()); err != nil {
+			err = ListDeliveriesRequestValidationError{
+				field:  "SubscriptionId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := DeliveryStatus_name[int32(m.GetStatus())]; !ok {
+		err := ListDeliveriesRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListDeliveriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListDeliveriesRequest) _validateUuid(uuid string) error {
+	if matched := _ufo_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListDeliveriesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListDeliveriesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeliveriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeliveriesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeliveriesRequestMultiError) AllErrors() []error { return m }
+
+// ListDeliveriesRequestValidationError is the validation error returned by
+// ListDeliveriesRequest.Validate if the designated constraints aren't met.
+type ListDeliveriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeliveriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeliveriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeliveriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeliveriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeliveriesRequestValidationError) ErrorName() string {
+	return "ListDeliveriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeliveriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeliveriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeliveriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeliveriesRequestValidationError{}
+
+// Validate checks the field values on ListDeliveriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeliveriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeliveriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeliveriesResponseMultiError, or nil if none found.
+func (m *ListDeliveriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeliveriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDeliveries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeliveriesResponseValidationError{
+						field:  fmt.Sprintf("Deliveries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeliveriesResponseValidationError{
+						field:  fmt.Sprintf("Deliveries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeliveriesResponseValidationError{
+					field:  fmt.Sprintf("Deliveries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListDeliveriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeliveriesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListDeliveriesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeliveriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeliveriesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeliveriesResponseMultiError) AllErrors() []error { return m }
+
+// ListDeliveriesResponseValidationError is the validation error returned by
+// ListDeliveriesResponse.Validate if the designated constraints aren't met.
+type ListDeliveriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeliveriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeliveriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeliveriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeliveriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeliveriesResponseValidationError) ErrorName() string {
+	return "ListDeliveriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeliveriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeliveriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeliveriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeliveriesResponseValidationError{}
@@ -20,26 +20,51 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UFOService_Create_FullMethodName = "/ufo.v1.UFOService/Create"
-	UFOService_Get_FullMethodName    = "/ufo.v1.UFOService/Get"
-	UFOService_Update_FullMethodName = "/ufo.v1.UFOService/Update"
-	UFOService_Delete_FullMethodName = "/ufo.v1.UFOService/Delete"
-	UFOService_GetAll_FullMethodName = "/ufo.v1.UFOService/GetAll"
+	UFOService_Create_FullMethodName             = "/ufo.v1.UFOService/Create"
+	UFOService_Get_FullMethodName                = "/ufo.v1.UFOService/Get"
+	UFOService_Update_FullMethodName             = "/ufo.v1.UFOService/Update"
+	UFOService_Delete_FullMethodName             = "/ufo.v1.UFOService/Delete"
+	UFOService_GetAll_FullMethodName             = "/ufo.v1.UFOService/GetAll"
+	UFOService_StreamAll_FullMethodName          = "/ufo.v1.UFOService/StreamAll"
+	UFOService_CreateSubscription_FullMethodName = "/ufo.v1.UFOService/CreateSubscription"
+	UFOService_ListSubscriptions_FullMethodName  = "/ufo.v1.UFOService/ListSubscriptions"
+	UFOService_DeleteSubscription_FullMethodName = "/ufo.v1.UFOService/DeleteSubscription"
+	UFOService_ListDeliveries_FullMethodName     = "/ufo.v1.UFOService/ListDeliveries"
 )
 
 // UFOServiceClient is the client API for UFOService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// UFOService первая версия API. Методы наблюдений устарели, им на смену
+// пришел ufo.v2.UFOService (/api/v2/ufo); подписки webhook остаются в v1.
 type UFOServiceClient interface {
+	// Deprecated: Do not use.
 	// Create создает новое наблюдение НЛО
 	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
+	// Deprecated: Do not use.
 	// Get возвращает наблюдение НЛО по идентификатору
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	// Deprecated: Do not use.
 	// Update обновляет существующее наблюдение НЛО
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Deprecated: Do not use.
 	// Delete выполняет мягкое удаление наблюдения НЛО
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Deprecated: Do not use.
+	// GetAll возвращает все наблюдения НЛО, включая удаленные
 	GetAll(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*GetAllResponse, error)
+	// Deprecated: Do not use.
+	// StreamAll передает все наблюдения НЛО потоком по одному, включая удаленные
+	StreamAll(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Sighting], error)
+	// CreateSubscription создает подписку на webhook уведомления о наблюдениях
+	CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*CreateSubscriptionResponse, error)
+	// ListSubscriptions возвращает все подписки без секретов
+	ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error)
+	// DeleteSubscription удаляет подписку, уже поставленные доставки не отменяются
+	DeleteSubscription(ctx context.Context, in *DeleteSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListDeliveries возвращает историю доставок webhook и список недоставленных (dead letter)
+	ListDeliveries(ctx context.Context, in *ListDeliveriesRequest, opts ...grpc.CallOption) (*ListDeliveriesResponse, error)
 }
 
 type uFOServiceClient struct {
@@ -50,6 +75,7 @@ func NewUFOServiceClient(cc grpc.ClientConnInterface) UFOServiceClient {
 	return &uFOServiceClient{cc}
 }
 
+// Deprecated: Do not use.
 func (c *uFOServiceClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateResponse)
@@ -60,6 +86,7 @@ func (c *uFOServiceClient) Create(ctx context.Context, in *CreateRequest, opts .
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *uFOServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetResponse)
@@ -70,6 +97,7 @@ func (c *uFOServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *uFOServiceClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -80,6 +108,7 @@ func (c *uFOServiceClient) Update(ctx context.Context, in *UpdateRequest, opts .
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *uFOServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -90,6 +119,7 @@ func (c *uFOServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts .
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *uFOServiceClient) GetAll(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*GetAllResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAllResponse)
@@ -100,19 +130,99 @@ func (c *uFOServiceClient) GetAll(ctx context.Context, in *GetAllRequest, opts .
 	return out, nil
 }
 
+// Deprecated: Do not use.
+func (c *uFOServiceClient) StreamAll(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Sighting], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UFOService_ServiceDesc.Streams[0], UFOService_StreamAll_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetAllRequest, Sighting]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UFOService_StreamAllClient = grpc.ServerStreamingClient[Sighting]
+
+func (c *uFOServiceClient) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*CreateSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSubscriptionResponse)
+	err := c.cc.Invoke(ctx, UFOService_CreateSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uFOServiceClient) ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, UFOService_ListSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uFOServiceClient) DeleteSubscription(ctx context.Context, in *DeleteSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UFOService_DeleteSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uFOServiceClient) ListDeliveries(ctx context.Context, in *ListDeliveriesRequest, opts ...grpc.CallOption) (*ListDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeliveriesResponse)
+	err := c.cc.Invoke(ctx, UFOService_ListDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UFOServiceServer is the server API for UFOService service.
 // All implementations must embed UnimplementedUFOServiceServer
 // for forward compatibility.
+//
+// UFOService первая версия API. Методы наблюдений устарели, им на смену
+// пришел ufo.v2.UFOService (/api/v2/ufo); подписки webhook остаются в v1.
 type UFOServiceServer interface {
+	// Deprecated: Do not use.
 	// Create создает новое наблюдение НЛО
 	Create(context.Context, *CreateRequest) (*CreateResponse, error)
+	// Deprecated: Do not use.
 	// Get возвращает наблюдение НЛО по идентификатору
 	Get(context.Context, *GetRequest) (*GetResponse, error)
+	// Deprecated: Do not use.
 	// Update обновляет существующее наблюдение НЛО
 	Update(context.Context, *UpdateRequest) (*emptypb.Empty, error)
+	// Deprecated: Do not use.
 	// Delete выполняет мягкое удаление наблюдения НЛО
 	Delete(context.Context, *DeleteRequest) (*emptypb.Empty, error)
+	// Deprecated: Do not use.
+	// GetAll возвращает все наблюдения НЛО, включая удаленные
 	GetAll(context.Context, *GetAllRequest) (*GetAllResponse, error)
+	// Deprecated: Do not use.
+	// StreamAll передает все наблюдения НЛО потоком по одному, включая удаленные
+	StreamAll(*GetAllRequest, grpc.ServerStreamingServer[Sighting]) error
+	// CreateSubscription создает подписку на webhook уведомления о наблюдениях
+	CreateSubscription(context.Context, *CreateSubscriptionRequest) (*CreateSubscriptionResponse, error)
+	// ListSubscriptions возвращает все подписки без секретов
+	ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error)
+	// DeleteSubscription удаляет подписку, уже поставленные доставки не отменяются
+	DeleteSubscription(context.Context, *DeleteSubscriptionRequest) (*emptypb.Empty, error)
+	// ListDeliveries возвращает историю доставок webhook и список недоставленных (dead letter)
+	ListDeliveries(context.Context, *ListDeliveriesRequest) (*ListDeliveriesResponse, error)
 	mustEmbedUnimplementedUFOServiceServer()
 }
 
@@ -138,6 +248,21 @@ func (UnimplementedUFOServiceServer) Delete(context.Context, *DeleteRequest) (*e
 func (UnimplementedUFOServiceServer) GetAll(context.Context, *GetAllRequest) (*GetAllResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAll not implemented")
 }
+func (UnimplementedUFOServiceServer) StreamAll(*GetAllRequest, grpc.ServerStreamingServer[Sighting]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAll not implemented")
+}
+func (UnimplementedUFOServiceServer) CreateSubscription(context.Context, *CreateSubscriptionRequest) (*CreateSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubscription not implemented")
+}
+func (UnimplementedUFOServiceServer) ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptions not implemented")
+}
+func (UnimplementedUFOServiceServer) DeleteSubscription(context.Context, *DeleteSubscriptionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubscription not implemented")
+}
+func (UnimplementedUFOServiceServer) ListDeliveries(context.Context, *ListDeliveriesRequest) (*ListDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeliveries not implemented")
+}
 func (UnimplementedUFOServiceServer) mustEmbedUnimplementedUFOServiceServer() {}
 func (UnimplementedUFOServiceServer) testEmbeddedByValue()                    {}
 
@@ -249,6 +374,89 @@ func _UFOService_GetAll_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UFOService_StreamAll_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetAllRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UFOServiceServer).StreamAll(m, &grpc.GenericServerStream[GetAllRequest, Sighting]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UFOService_StreamAllServer = grpc.ServerStreamingServer[Sighting]
+
+func _UFOService_CreateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UFOServiceServer).CreateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UFOService_CreateSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UFOServiceServer).CreateSubscription(ctx, req.(*CreateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UFOService_ListSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UFOServiceServer).ListSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UFOService_ListSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UFOServiceServer).ListSubscriptions(ctx, req.(*ListSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UFOService_DeleteSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UFOServiceServer).DeleteSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UFOService_DeleteSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UFOServiceServer).DeleteSubscription(ctx, req.(*DeleteSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UFOService_ListDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UFOServiceServer).ListDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UFOService_ListDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UFOServiceServer).ListDeliveries(ctx, req.(*ListDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UFOService_ServiceDesc is the grpc.ServiceDesc for UFOService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -276,7 +484,29 @@ var UFOService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAll",
 			Handler:    _UFOService_GetAll_Handler,
 		},
+		{
+			MethodName: "CreateSubscription",
+			Handler:    _UFOService_CreateSubscription_Handler,
+		},
+		{
+			MethodName: "ListSubscriptions",
+			Handler:    _UFOService_ListSubscriptions_Handler,
+		},
+		{
+			MethodName: "DeleteSubscription",
+			Handler:    _UFOService_DeleteSubscription_Handler,
+		},
+		{
+			MethodName: "ListDeliveries",
+			Handler:    _UFOService_ListDeliveries_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamAll",
+			Handler:       _UFOService_StreamAll_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "ufo/v1/ufo.proto",
 }
@@ -0,0 +1,875 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: ufo/v2/ufo.proto
+
+package ufo_v2
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Color каноническая палитра цветов
+type Color int32
+
+const (
+	// COLOR_UNSPECIFIED цвет не указан или не распознан
+	Color_COLOR_UNSPECIFIED Color = 0
+	Color_COLOR_RED         Color = 1
+	Color_COLOR_ORANGE      Color = 2
+	Color_COLOR_YELLOW      Color = 3
+	Color_COLOR_GREEN       Color = 4
+	Color_COLOR_CYAN        Color = 5
+	Color_COLOR_BLUE        Color = 6
+	Color_COLOR_PURPLE      Color = 7
+	Color_COLOR_PINK        Color = 8
+	Color_COLOR_BROWN       Color = 9
+	Color_COLOR_WHITE       Color = 10
+	Color_COLOR_GRAY        Color = 11
+	Color_COLOR_BLACK       Color = 12
+)
+
+// Enum value maps for Color.
+var (
+	Color_name = map[int32]string{
+		0:  "COLOR_UNSPECIFIED",
+		1:  "COLOR_RED",
+		2:  "COLOR_ORANGE",
+		3:  "COLOR_YELLOW",
+		4:  "COLOR_GREEN",
+		5:  "COLOR_CYAN",
+		6:  "COLOR_BLUE",
+		7:  "COLOR_PURPLE",
+		8:  "COLOR_PINK",
+		9:  "COLOR_BROWN",
+		10: "COLOR_WHITE",
+		11: "COLOR_GRAY",
+		12: "COLOR_BLACK",
+	}
+	Color_value = map[string]int32{
+		"COLOR_UNSPECIFIED": 0,
+		"COLOR_RED":         1,
+		"COLOR_ORANGE":      2,
+		"COLOR_YELLOW":      3,
+		"COLOR_GREEN":       4,
+		"COLOR_CYAN":        5,
+		"COLOR_BLUE":        6,
+		"COLOR_PURPLE":      7,
+		"COLOR_PINK":        8,
+		"COLOR_BROWN":       9,
+		"COLOR_WHITE":       10,
+		"COLOR_GRAY":        11,
+		"COLOR_BLACK":       12,
+	}
+)
+
+func (x Color) Enum() *Color {
+	p := new(Color)
+	*p = x
+	return p
+}
+
+func (x Color) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Color) Descriptor() protoreflect.EnumDescriptor {
+	return file_ufo_v2_ufo_proto_enumTypes[0].Descriptor()
+}
+
+func (Color) Type() protoreflect.EnumType {
+	return &file_ufo_v2_ufo_proto_enumTypes[0]
+}
+
+func (x Color) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Color.Descriptor instead.
+func (Color) EnumDescriptor() ([]byte, []int) {
+	return file_ufo_v2_ufo_proto_rawDescGZIP(), []int{0}
+}
+
+// Sound наличие звука при наблюдении
+type Sound int32
+
+const (
+	// SOUND_UNSPECIFIED не известно
+	Sound_SOUND_UNSPECIFIED Sound = 0
+	// SOUND_PRESENT объект издавал звук
+	Sound_SOUND_PRESENT Sound = 1
+	// SOUND_ABSENT объект был беззвучным
+	Sound_SOUND_ABSENT Sound = 2
+)
+
+// Enum value maps for Sound.
+var (
+	Sound_name = map[int32]string{
+		0: "SOUND_UNSPECIFIED",
+		1: "SOUND_PRESENT",
+		2: "SOUND_ABSENT",
+	}
+	Sound_value = map[string]int32{
+		"SOUND_UNSPECIFIED": 0,
+		"SOUND_PRESENT":     1,
+		"SOUND_ABSENT":      2,
+	}
+)
+
+func (x Sound) Enum() *Sound {
+	p := new(Sound)
+	*p = x
+	return p
+}
+
+func (x Sound) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Sound) Descriptor() protoreflect.EnumDescriptor {
+	return file_ufo_v2_ufo_proto_enumTypes[1].Descriptor()
+}
+
+func (Sound) Type() protoreflect.EnumType {
+	return &file_ufo_v2_ufo_proto_enumTypes[1]
+}
+
+func (x Sound) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Sound.Descriptor instead.
+func (Sound) EnumDescriptor() ([]byte, []int) {
+	return file_ufo_v2_ufo_proto_rawDescGZIP(), []int{1}
+}
+
+// Coordinates географические координаты в градусах WGS 84
+type Coordinates struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// latitude широта
+	Latitude float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// longitude долгота
+	Longitude     float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Coordinates) Reset() {
+	*x = Coordinates{}
+	mi := &file_ufo_v2_ufo_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Coordinates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coordinates) ProtoMessage() {}
+
+func (x *Coordinates) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v2_ufo_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coordinates.ProtoReflect.Descriptor instead.
+func (*Coordinates) Descriptor() ([]byte, []int) {
+	return file_ufo_v2_ufo_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Coordinates) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Coordinates) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+// Location место наблюдения
+type Location struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name название места, обязательно для наблюдения (проверяется сервером:
+	// при частичном обновлении в запросе может быть только coordinates)
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// coordinates координаты места (опционально)
+	Coordinates   *Coordinates `protobuf:"bytes,2,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_ufo_v2_ufo_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v2_ufo_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_ufo_v2_ufo_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Location) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Location) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+// Sighting наблюдение НЛО
+type Sighting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuid уникальный идентификатор наблюдения, задается сервером
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// observed_at время наблюдения НЛО, не в будущем (проверяется сервером)
+	ObservedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=observed_at,json=observedAt,proto3" json:"observed_at,omitempty"`
+	// location место наблюдения, обязательно (проверяется сервером)
+	Location *Location `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	// description описание наблюдаемого объекта, до 1000 символов
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// color_text цвет объекта в свободной форме (опционально): название на русском
+	// или английском, #rgb, #rrggbb или rgb(r, g, b)
+	ColorText *string `protobuf:"bytes,5,opt,name=color_text,json=colorText,proto3,oneof" json:"color_text,omitempty"`
+	// color цвет из палитры, вычисляется сервером по color_text
+	Color Color `protobuf:"varint,6,opt,name=color,proto3,enum=ufo.v2.Color" json:"color,omitempty"`
+	// sound наличие звука
+	Sound Sound `protobuf:"varint,7,opt,name=sound,proto3,enum=ufo.v2.Sound" json:"sound,omitempty"`
+	// duration продолжительность наблюдения в целых секундах, до суток (опционально)
+	Duration *durationpb.Duration `protobuf:"bytes,8,opt,name=duration,proto3" json:"duration,omitempty"`
+	// create_time время создания записи, задается сервером
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// update_time время последнего обновления записи, задается сервером
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// delete_time время мягкого удаления записи, задается сервером
+	DeleteTime    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=delete_time,json=deleteTime,proto3" json:"delete_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Sighting) Reset() {
+	*x = Sighting{}
+	mi := &file_ufo_v2_ufo_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sighting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sighting) ProtoMessage() {}
+
+func (x *Sighting) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v2_ufo_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sighting.ProtoReflect.Descriptor instead.
+func (*Sighting) Descriptor() ([]byte, []int) {
+	return file_ufo_v2_ufo_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Sighting) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Sighting) GetObservedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ObservedAt
+	}
+	return nil
+}
+
+func (x *Sighting) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Sighting) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Sighting) GetColorText() string {
+	if x != nil && x.ColorText != nil {
+		return *x.ColorText
+	}
+	return ""
+}
+
+func (x *Sighting) GetColor() Color {
+	if x != nil {
+		return x.Color
+	}
+	return Color_COLOR_UNSPECIFIED
+}
+
+func (x *Sighting) GetSound() Sound {
+	if x != nil {
+		return x.Sound
+	}
+	return Sound_SOUND_UNSPECIFIED
+}
+
+func (x *Sighting) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *Sighting) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Sighting) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Sighting) GetDeleteTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeleteTime
+	}
+	return nil
+}
+
+// CreateSightingRequest запрос на создание наблюдения
+type CreateSightingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sighting данные наблюдения; uuid, color и поля времени записи игнорируются
+	Sighting      *Sighting `protobuf:"bytes,1,opt,name=sighting,proto3" json:"sighting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSightingRequest) Reset() {
+	*x = CreateSightingRequest{}
+	mi := &file_ufo_v2_ufo_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSightingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSightingRequest) ProtoMessage() {}
+
+func (x *CreateSightingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v2_ufo_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSightingRequest.ProtoReflect.Descriptor instead.
+func (*CreateSightingRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v2_ufo_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateSightingRequest) GetSighting() *Sighting {
+	if x != nil {
+		return x.Sighting
+	}
+	return nil
+}
+
+// GetSightingRequest запрос наблюдения по идентификатору
+type GetSightingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuid идентификатор наблюдения
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSightingRequest) Reset() {
+	*x = GetSightingRequest{}
+	mi := &file_ufo_v2_ufo_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSightingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSightingRequest) ProtoMessage() {}
+
+func (x *GetSightingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v2_ufo_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSightingRequest.ProtoReflect.Descriptor instead.
+func (*GetSightingRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v2_ufo_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetSightingRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// UpdateSightingRequest запрос на частичное обновление наблюдения
+type UpdateSightingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sighting новые значения полей, uuid определяет наблюдение
+	Sighting *Sighting `protobuf:"bytes,1,opt,name=sighting,proto3" json:"sighting,omitempty"`
+	// update_mask обновляемые поля: observed_at, location, location.name,
+	// location.coordinates, description, color_text, sound, duration.
+	// Пустая маска или "*" заменяет все изменяемые поля. Gateway заполняет маску
+	// по полям тела PATCH запроса, если она не передана явно.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSightingRequest) Reset() {
+	*x = UpdateSightingRequest{}
+	mi := &file_ufo_v2_ufo_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSightingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSightingRequest) ProtoMessage() {}
+
+func (x *UpdateSightingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v2_ufo_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSightingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSightingRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v2_ufo_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateSightingRequest) GetSighting() *Sighting {
+	if x != nil {
+		return x.Sighting
+	}
+	return nil
+}
+
+func (x *UpdateSightingRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// DeleteSightingRequest запрос на удаление наблюдения
+type DeleteSightingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuid идентификатор наблюдения
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSightingRequest) Reset() {
+	*x = DeleteSightingRequest{}
+	mi := &file_ufo_v2_ufo_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSightingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSightingRequest) ProtoMessage() {}
+
+func (x *DeleteSightingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v2_ufo_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSightingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSightingRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v2_ufo_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteSightingRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// ListSightingsRequest запрос списка наблюдений
+type ListSightingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// show_deleted включать мягко удаленные наблюдения
+	ShowDeleted   bool `protobuf:"varint,1,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSightingsRequest) Reset() {
+	*x = ListSightingsRequest{}
+	mi := &file_ufo_v2_ufo_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSightingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSightingsRequest) ProtoMessage() {}
+
+func (x *ListSightingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v2_ufo_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSightingsRequest.ProtoReflect.Descriptor instead.
+func (*ListSightingsRequest) Descriptor() ([]byte, []int) {
+	return file_ufo_v2_ufo_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListSightingsRequest) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
+// ListSightingsResponse ответ со списком наблюдений
+type ListSightingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sightings список наблюдений
+	Sightings []*Sighting `protobuf:"bytes,1,rep,name=sightings,proto3" json:"sightings,omitempty"`
+	// total_count количество наблюдений в ответе
+	TotalCount    int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSightingsResponse) Reset() {
+	*x = ListSightingsResponse{}
+	mi := &file_ufo_v2_ufo_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSightingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSightingsResponse) ProtoMessage() {}
+
+func (x *ListSightingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ufo_v2_ufo_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSightingsResponse.ProtoReflect.Descriptor instead.
+func (*ListSightingsResponse) Descriptor() ([]byte, []int) {
+	return file_ufo_v2_ufo_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListSightingsResponse) GetSightings() []*Sighting {
+	if x != nil {
+		return x.Sightings
+	}
+	return nil
+}
+
+func (x *ListSightingsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+var File_ufo_v2_ufo_proto protoreflect.FileDescriptor
+
+const file_ufo_v2_ufo_proto_rawDesc = "" +
+	"\n" +
+	"\x10ufo/v2/ufo.proto\x12\x06ufo.v2\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"y\n" +
+	"\vCoordinates\x123\n" +
+	"\blatitude\x18\x01 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80V@)\x00\x00\x00\x00\x00\x80V\xc0R\blatitude\x125\n" +
+	"\tlongitude\x18\x02 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80f@)\x00\x00\x00\x00\x00\x80f\xc0R\tlongitude\"^\n" +
+	"\bLocation\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x182R\x04name\x125\n" +
+	"\vcoordinates\x18\x02 \x01(\v2\x13.ufo.v2.CoordinatesR\vcoordinates\"\xcf\x04\n" +
+	"\bSighting\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12;\n" +
+	"\vobserved_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"observedAt\x12,\n" +
+	"\blocation\x18\x03 \x01(\v2\x10.ufo.v2.LocationR\blocation\x12*\n" +
+	"\vdescription\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xe8\aR\vdescription\x12-\n" +
+	"\n" +
+	"color_text\x18\x05 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18 H\x00R\tcolorText\x88\x01\x01\x12-\n" +
+	"\x05color\x18\x06 \x01(\x0e2\r.ufo.v2.ColorB\b\xfaB\x05\x82\x01\x02\x10\x01R\x05color\x12-\n" +
+	"\x05sound\x18\a \x01(\x0e2\r.ufo.v2.SoundB\b\xfaB\x05\x82\x01\x02\x10\x01R\x05sound\x12E\n" +
+	"\bduration\x18\b \x01(\v2\x19.google.protobuf.DurationB\x0e\xfaB\v\xaa\x01\b\"\x04\b\x80\xa3\x05*\x00R\bduration\x12;\n" +
+	"\vcreate_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12;\n" +
+	"\vdelete_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"deleteTimeB\r\n" +
+	"\v_color_text\"O\n" +
+	"\x15CreateSightingRequest\x126\n" +
+	"\bsighting\x18\x01 \x01(\v2\x10.ufo.v2.SightingB\b\xfaB\x05\x8a\x01\x02\x10\x01R\bsighting\"2\n" +
+	"\x12GetSightingRequest\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\"\x8c\x01\n" +
+	"\x15UpdateSightingRequest\x126\n" +
+	"\bsighting\x18\x01 \x01(\v2\x10.ufo.v2.SightingB\b\xfaB\x05\x8a\x01\x02\x10\x01R\bsighting\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"5\n" +
+	"\x15DeleteSightingRequest\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\"9\n" +
+	"\x14ListSightingsRequest\x12!\n" +
+	"\fshow_deleted\x18\x01 \x01(\bR\vshowDeleted\"h\n" +
+	"\x15ListSightingsResponse\x12.\n" +
+	"\tsightings\x18\x01 \x03(\v2\x10.ufo.v2.SightingR\tsightings\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount*\xe7\x01\n" +
+	"\x05Color\x12\x15\n" +
+	"\x11COLOR_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tCOLOR_RED\x10\x01\x12\x10\n" +
+	"\fCOLOR_ORANGE\x10\x02\x12\x10\n" +
+	"\fCOLOR_YELLOW\x10\x03\x12\x0f\n" +
+	"\vCOLOR_GREEN\x10\x04\x12\x0e\n" +
+	"\n" +
+	"COLOR_CYAN\x10\x05\x12\x0e\n" +
+	"\n" +
+	"COLOR_BLUE\x10\x06\x12\x10\n" +
+	"\fCOLOR_PURPLE\x10\a\x12\x0e\n" +
+	"\n" +
+	"COLOR_PINK\x10\b\x12\x0f\n" +
+	"\vCOLOR_BROWN\x10\t\x12\x0f\n" +
+	"\vCOLOR_WHITE\x10\n" +
+	"\x12\x0e\n" +
+	"\n" +
+	"COLOR_GRAY\x10\v\x12\x0f\n" +
+	"\vCOLOR_BLACK\x10\f*C\n" +
+	"\x05Sound\x12\x15\n" +
+	"\x11SOUND_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rSOUND_PRESENT\x10\x01\x12\x10\n" +
+	"\fSOUND_ABSENT\x10\x022\xe2\x04\n" +
+	"\n" +
+	"UFOService\x12`\n" +
+	"\x0eCreateSighting\x12\x1d.ufo.v2.CreateSightingRequest\x1a\x10.ufo.v2.Sighting\"\x1d\x82\xd3\xe4\x93\x02\x17:\bsighting\"\v/api/v2/ufo\x12W\n" +
+	"\vGetSighting\x12\x1a.ufo.v2.GetSightingRequest\x1a\x10.ufo.v2.Sighting\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v2/ufo/{uuid}\x12p\n" +
+	"\x0eUpdateSighting\x12\x1d.ufo.v2.UpdateSightingRequest\x1a\x10.ufo.v2.Sighting\"-\x82\xd3\xe4\x93\x02':\bsighting2\x1b/api/v2/ufo/{sighting.uuid}\x12c\n" +
+	"\x0eDeleteSighting\x12\x1d.ufo.v2.DeleteSightingRequest\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/api/v2/ufo/{uuid}\x12a\n" +
+	"\rListSightings\x12\x1c.ufo.v2.ListSightingsRequest\x1a\x1d.ufo.v2.ListSightingsResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/api/v2/ufo\x12_\n" +
+	"\x0fStreamSightings\x12\x1c.ufo.v2.ListSightingsRequest\x1a\x10.ufo.v2.Sighting\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v2/ufo:stream0\x01BVZTgithub.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v2;ufo_v2b\x06proto3"
+
+var (
+	file_ufo_v2_ufo_proto_rawDescOnce sync.Once
+	file_ufo_v2_ufo_proto_rawDescData []byte
+)
+
+func file_ufo_v2_ufo_proto_rawDescGZIP() []byte {
+	file_ufo_v2_ufo_proto_rawDescOnce.Do(func() {
+		file_ufo_v2_ufo_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ufo_v2_ufo_proto_rawDesc), len(file_ufo_v2_ufo_proto_rawDesc)))
+	})
+	return file_ufo_v2_ufo_proto_rawDescData
+}
+
+var file_ufo_v2_ufo_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_ufo_v2_ufo_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_ufo_v2_ufo_proto_goTypes = []any{
+	(Color)(0),                    // 0: ufo.v2.Color
+	(Sound)(0),                    // 1: ufo.v2.Sound
+	(*Coordinates)(nil),           // 2: ufo.v2.Coordinates
+	(*Location)(nil),              // 3: ufo.v2.Location
+	(*Sighting)(nil),              // 4: ufo.v2.Sighting
+	(*CreateSightingRequest)(nil), // 5: ufo.v2.CreateSightingRequest
+	(*GetSightingRequest)(nil),    // 6: ufo.v2.GetSightingRequest
+	(*UpdateSightingRequest)(nil), // 7: ufo.v2.UpdateSightingRequest
+	(*DeleteSightingRequest)(nil), // 8: ufo.v2.DeleteSightingRequest
+	(*ListSightingsRequest)(nil),  // 9: ufo.v2.ListSightingsRequest
+	(*ListSightingsResponse)(nil), // 10: ufo.v2.ListSightingsResponse
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 12: google.protobuf.Duration
+	(*fieldmaskpb.FieldMask)(nil), // 13: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 14: google.protobuf.Empty
+}
+var file_ufo_v2_ufo_proto_depIdxs = []int32{
+	2,  // 0: ufo.v2.Location.coordinates:type_name -> ufo.v2.Coordinates
+	11, // 1: ufo.v2.Sighting.observed_at:type_name -> google.protobuf.Timestamp
+	3,  // 2: ufo.v2.Sighting.location:type_name -> ufo.v2.Location
+	0,  // 3: ufo.v2.Sighting.color:type_name -> ufo.v2.Color
+	1,  // 4: ufo.v2.Sighting.sound:type_name -> ufo.v2.Sound
+	12, // 5: ufo.v2.Sighting.duration:type_name -> google.protobuf.Duration
+	11, // 6: ufo.v2.Sighting.create_time:type_name -> google.protobuf.Timestamp
+	11, // 7: ufo.v2.Sighting.update_time:type_name -> google.protobuf.Timestamp
+	11, // 8: ufo.v2.Sighting.delete_time:type_name -> google.protobuf.Timestamp
+	4,  // 9: ufo.v2.CreateSightingRequest.sighting:type_name -> ufo.v2.Sighting
+	4,  // 10: ufo.v2.UpdateSightingRequest.sighting:type_name -> ufo.v2.Sighting
+	13, // 11: ufo.v2.UpdateSightingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	4,  // 12: ufo.v2.ListSightingsResponse.sightings:type_name -> ufo.v2.Sighting
+	5,  // 13: ufo.v2.UFOService.CreateSighting:input_type -> ufo.v2.CreateSightingRequest
+	6,  // 14: ufo.v2.UFOService.GetSighting:input_type -> ufo.v2.GetSightingRequest
+	7,  // 15: ufo.v2.UFOService.UpdateSighting:input_type -> ufo.v2.UpdateSightingRequest
+	8,  // 16: ufo.v2.UFOService.DeleteSighting:input_type -> ufo.v2.DeleteSightingRequest
+	9,  // 17: ufo.v2.UFOService.ListSightings:input_type -> ufo.v2.ListSightingsRequest
+	9,  // 18: ufo.v2.UFOService.StreamSightings:input_type -> ufo.v2.ListSightingsRequest
+	4,  // 19: ufo.v2.UFOService.CreateSighting:output_type -> ufo.v2.Sighting
+	4,  // 20: ufo.v2.UFOService.GetSighting:output_type -> ufo.v2.Sighting
+	4,  // 21: ufo.v2.UFOService.UpdateSighting:output_type -> ufo.v2.Sighting
+	14, // 22: ufo.v2.UFOService.DeleteSighting:output_type -> google.protobuf.Empty
+	10, // 23: ufo.v2.UFOService.ListSightings:output_type -> ufo.v2.ListSightingsResponse
+	4,  // 24: ufo.v2.UFOService.StreamSightings:output_type -> ufo.v2.Sighting
+	19, // [19:25] is the sub-list for method output_type
+	13, // [13:19] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_ufo_v2_ufo_proto_init() }
+func file_ufo_v2_ufo_proto_init() {
+	if File_ufo_v2_ufo_proto != nil {
+		return
+	}
+	file_ufo_v2_ufo_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ufo_v2_ufo_proto_rawDesc), len(file_ufo_v2_ufo_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ufo_v2_ufo_proto_goTypes,
+		DependencyIndexes: file_ufo_v2_ufo_proto_depIdxs,
+		EnumInfos:         file_ufo_v2_ufo_proto_enumTypes,
+		MessageInfos:      file_ufo_v2_ufo_proto_msgTypes,
+	}.Build()
+	File_ufo_v2_ufo_proto = out.File
+	file_ufo_v2_ufo_proto_goTypes = nil
+	file_ufo_v2_ufo_proto_depIdxs = nil
+}
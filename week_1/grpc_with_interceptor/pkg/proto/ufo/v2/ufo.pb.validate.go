@@ -0,0 +1,1423 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: ufo/v2/ufo.proto
+
+package ufo_v2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _ufo_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on Coordinates with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Coordinates) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Coordinates with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CoordinatesMultiError, or
+// nil if none found.
+func (m *Coordinates) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Coordinates) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetLatitude(); val < -90 || val > 90 {
+		err := CoordinatesValidationError{
+			field:  "Latitude",
+			reason: "value must be inside range [-90, 90]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLongitude(); val < -180 || val > 180 {
+		err := CoordinatesValidationError{
+			field:  "Longitude",
+			reason: "value must be inside range [-180, 180]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CoordinatesMultiError(errors)
+	}
+
+	return nil
+}
+
+// CoordinatesMultiError is an error wrapping multiple validation errors
+// returned by Coordinates.ValidateAll() if the designated constraints aren't met.
+type CoordinatesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CoordinatesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CoordinatesMultiError) AllErrors() []error { return m }
+
+// CoordinatesValidationError is the validation error returned by
+// Coordinates.Validate if the designated constraints aren't met.
+type CoordinatesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CoordinatesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CoordinatesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CoordinatesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CoordinatesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CoordinatesValidationError) ErrorName() string { return "CoordinatesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CoordinatesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCoordinates.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CoordinatesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CoordinatesValidationError{}
+
+// Validate checks the field values on Location with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Location) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Location with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LocationMultiError, or nil
+// if none found.
+func (m *Location) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Location) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 50 {
+		err := LocationValidationError{
+			field:  "Name",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCoordinates()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LocationValidationError{
+					field:  "Coordinates",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LocationValidationError{
+					field:  "Coordinates",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCoordinates()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LocationValidationError{
+				field:  "Coordinates",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LocationMultiError(errors)
+	}
+
+	return nil
+}
+
+// LocationMultiError is an error wrapping multiple validation errors returned
+// by Location.ValidateAll() if the designated constraints aren't met.
+type LocationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LocationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LocationMultiError) AllErrors() []error { return m }
+
+// LocationValidationError is the validation error returned by
+// Location.Validate if the designated constraints aren't met.
+type LocationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LocationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LocationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LocationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LocationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LocationValidationError) ErrorName() string { return "LocationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LocationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LocationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LocationValidationError{}
+
+// Validate checks the field values on Sighting with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Sighting) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Sighting with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SightingMultiError, or nil
+// if none found.
+func (m *Sighting) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Sighting) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uuid
+
+	if all {
+		switch v := interface{}(m.GetObservedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SightingValidationError{
+					field:  "ObservedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SightingValidationError{
+					field:  "ObservedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetObservedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SightingValidationError{
+				field:  "ObservedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SightingValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SightingValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SightingValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 1000 {
+		err := SightingValidationError{
+			field:  "Description",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Color_name[int32(m.GetColor())]; !ok {
+		err := SightingValidationError{
+			field:  "Color",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Sound_name[int32(m.GetSound())]; !ok {
+		err := SightingValidationError{
+			field:  "Sound",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetDuration(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = SightingValidationError{
+				field:  "Duration",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			lte := time.Duration(86400*time.Second + 0*time.Nanosecond)
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt || dur > lte {
+				err := SightingValidationError{
+					field:  "Duration",
+					reason: "value must be inside range (0s, 24h0m0s]",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SightingValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SightingValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SightingValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SightingValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SightingValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SightingValidationError{
+				field:  "UpdateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeleteTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SightingValidationError{
+					field:  "DeleteTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SightingValidationError{
+					field:  "DeleteTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeleteTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SightingValidationError{
+				field:  "DeleteTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.ColorText != nil {
+
+		if l := utf8.RuneCountInString(m.GetColorText()); l < 1 || l > 32 {
+			err := SightingValidationError{
+				field:  "ColorText",
+				reason: "value length must be between 1 and 32 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SightingMultiError(errors)
+	}
+
+	return nil
+}
+
+// SightingMultiError is an error wrapping multiple validation errors returned
+// by Sighting.ValidateAll() if the designated constraints aren't met.
+type SightingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SightingMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SightingMultiError) AllErrors() []error { return m }
+
+// SightingValidationError is the validation error returned by
+// Sighting.Validate if the designated constraints aren't met.
+type SightingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SightingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SightingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SightingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SightingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SightingValidationError) ErrorName() string { return "SightingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SightingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSighting.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SightingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SightingValidationError{}
+
+// Validate checks the field values on CreateSightingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSightingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSightingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSightingRequestMultiError, or nil if none found.
+func (m *CreateSightingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSightingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSighting() == nil {
+		err := CreateSightingRequestValidationError{
+			field:  "Sighting",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSighting()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSightingRequestValidationError{
+					field:  "Sighting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSightingRequestValidationError{
+					field:  "Sighting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSighting()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSightingRequestValidationError{
+				field:  "Sighting",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateSightingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSightingRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSightingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSightingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSightingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSightingRequestMultiError) AllErrors() []error { return m }
+
+// CreateSightingRequestValidationError is the validation error returned by
+// CreateSightingRequest.Validate if the designated constraints aren't met.
+type CreateSightingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSightingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSightingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSightingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSightingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSightingRequestValidationError) ErrorName() string {
+	return "CreateSightingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSightingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSightingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSightingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSightingRequestValidationError{}
+
+// Validate checks the field values on GetSightingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSightingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSightingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSightingRequestMultiError, or nil if none found.
+func (m *GetSightingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSightingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = GetSightingRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetSightingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetSightingRequest) _validateUuid(uuid string) error {
+	if matched := _ufo_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetSightingRequestMultiError is an error wrapping multiple validation errors
+// returned by GetSightingRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetSightingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSightingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSightingRequestMultiError) AllErrors() []error { return m }
+
+// GetSightingRequestValidationError is the validation error returned by
+// GetSightingRequest.Validate if the designated constraints aren't met.
+type GetSightingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSightingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSightingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSightingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSightingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSightingRequestValidationError) ErrorName() string {
+	return "GetSightingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSightingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSightingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSightingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSightingRequestValidationError{}
+
+// Validate checks the field values on UpdateSightingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSightingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSightingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSightingRequestMultiError, or nil if none found.
+func (m *UpdateSightingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSightingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSighting() == nil {
+		err := UpdateSightingRequestValidationError{
+			field:  "Sighting",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSighting()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSightingRequestValidationError{
+					field:  "Sighting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSightingRequestValidationError{
+					field:  "Sighting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSighting()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSightingRequestValidationError{
+				field:  "Sighting",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSightingRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSightingRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSightingRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateSightingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSightingRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateSightingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateSightingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSightingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSightingRequestMultiError) AllErrors() []error { return m }
+
+// UpdateSightingRequestValidationError is the validation error returned by
+// UpdateSightingRequest.Validate if the designated constraints aren't met.
+type UpdateSightingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSightingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSightingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSightingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSightingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSightingRequestValidationError) ErrorName() string {
+	return "UpdateSightingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSightingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSightingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSightingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSightingRequestValidationError{}
+
+// Validate checks the field values on DeleteSightingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSightingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSightingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSightingRequestMultiError, or nil if none found.
+func (m *DeleteSightingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSightingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = DeleteSightingRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteSightingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteSightingRequest) _validateUuid(uuid string) error {
+	if matched := _ufo_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteSightingRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSightingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSightingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSightingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSightingRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSightingRequestValidationError is the validation error returned by
+// DeleteSightingRequest.Validate if the designated constraints aren't met.
+type DeleteSightingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSightingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSightingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSightingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSightingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSightingRequestValidationError) ErrorName() string {
+	return "DeleteSightingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSightingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSightingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSightingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSightingRequestValidationError{}
+
+// Validate checks the field values on ListSightingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSightingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSightingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSightingsRequestMultiError, or nil if none found.
+func (m *ListSightingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSightingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ShowDeleted
+
+	if len(errors) > 0 {
+		return ListSightingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSightingsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSightingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSightingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSightingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSightingsRequestMultiError) AllErrors() []error { return m }
+
+// ListSightingsRequestValidationError is the validation error returned by
+// ListSightingsRequest.Validate if the designated constraints aren't met.
+type ListSightingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSightingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSightingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSightingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSightingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSightingsRequestValidationError) ErrorName() string {
+	return "ListSightingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSightingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSightingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSightingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSightingsRequestValidationError{}
+
+// Validate checks the field values on ListSightingsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSightingsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSightingsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSightingsResponseMultiError, or nil if none found.
+func (m *ListSightingsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSightingsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSightings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSightingsResponseValidationError{
+						field:  fmt.Sprintf("Sightings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSightingsResponseValidationError{
+						field:  fmt.Sprintf("Sightings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSightingsResponseValidationError{
+					field:  fmt.Sprintf("Sightings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if len(errors) > 0 {
+		return ListSightingsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSightingsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSightingsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSightingsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSightingsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSightingsResponseMultiError) AllErrors() []error { return m }
+
+// ListSightingsResponseValidationError is the validation error returned by
+// ListSightingsResponse.Validate if the designated constraints aren't met.
+type ListSightingsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSightingsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSightingsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSightingsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSightingsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSightingsResponseValidationError) ErrorName() string {
+	return "ListSightingsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSightingsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSightingsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSightingsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSightingsResponseValidationError{}
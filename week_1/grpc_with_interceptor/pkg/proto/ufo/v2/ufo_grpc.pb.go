@@ -0,0 +1,334 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: ufo/v2/ufo.proto
+
+package ufo_v2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	UFOService_CreateSighting_FullMethodName  = "/ufo.v2.UFOService/CreateSighting"
+	UFOService_GetSighting_FullMethodName     = "/ufo.v2.UFOService/GetSighting"
+	UFOService_UpdateSighting_FullMethodName  = "/ufo.v2.UFOService/UpdateSighting"
+	UFOService_DeleteSighting_FullMethodName  = "/ufo.v2.UFOService/DeleteSighting"
+	UFOService_ListSightings_FullMethodName   = "/ufo.v2.UFOService/ListSightings"
+	UFOService_StreamSightings_FullMethodName = "/ufo.v2.UFOService/StreamSightings"
+)
+
+// UFOServiceClient is the client API for UFOService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// UFOService вторая версия API наблюдений НЛО. Хранилище общее с ufo.v1:
+// наблюдение, созданное через одну версию, доступно через другую.
+type UFOServiceClient interface {
+	// CreateSighting создает новое наблюдение НЛО и возвращает его
+	CreateSighting(ctx context.Context, in *CreateSightingRequest, opts ...grpc.CallOption) (*Sighting, error)
+	// GetSighting возвращает наблюдение НЛО по идентификатору
+	GetSighting(ctx context.Context, in *GetSightingRequest, opts ...grpc.CallOption) (*Sighting, error)
+	// UpdateSighting обновляет поля наблюдения из update_mask и возвращает новую версию
+	UpdateSighting(ctx context.Context, in *UpdateSightingRequest, opts ...grpc.CallOption) (*Sighting, error)
+	// DeleteSighting выполняет мягкое удаление наблюдения
+	DeleteSighting(ctx context.Context, in *DeleteSightingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListSightings возвращает наблюдения НЛО, удаленные — только с show_deleted
+	ListSightings(ctx context.Context, in *ListSightingsRequest, opts ...grpc.CallOption) (*ListSightingsResponse, error)
+	// StreamSightings передает наблюдения потоком по одному с тем же отбором, что ListSightings
+	StreamSightings(ctx context.Context, in *ListSightingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Sighting], error)
+}
+
+type uFOServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUFOServiceClient(cc grpc.ClientConnInterface) UFOServiceClient {
+	return &uFOServiceClient{cc}
+}
+
+func (c *uFOServiceClient) CreateSighting(ctx context.Context, in *CreateSightingRequest, opts ...grpc.CallOption) (*Sighting, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Sighting)
+	err := c.cc.Invoke(ctx, UFOService_CreateSighting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uFOServiceClient) GetSighting(ctx context.Context, in *GetSightingRequest, opts ...grpc.CallOption) (*Sighting, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Sighting)
+	err := c.cc.Invoke(ctx, UFOService_GetSighting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uFOServiceClient) UpdateSighting(ctx context.Context, in *UpdateSightingRequest, opts ...grpc.CallOption) (*Sighting, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Sighting)
+	err := c.cc.Invoke(ctx, UFOService_UpdateSighting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uFOServiceClient) DeleteSighting(ctx context.Context, in *DeleteSightingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UFOService_DeleteSighting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uFOServiceClient) ListSightings(ctx context.Context, in *ListSightingsRequest, opts ...grpc.CallOption) (*ListSightingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSightingsResponse)
+	err := c.cc.Invoke(ctx, UFOService_ListSightings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uFOServiceClient) StreamSightings(ctx context.Context, in *ListSightingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Sighting], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UFOService_ServiceDesc.Streams[0], UFOService_StreamSightings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListSightingsRequest, Sighting]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UFOService_StreamSightingsClient = grpc.ServerStreamingClient[Sighting]
+
+// UFOServiceServer is the server API for UFOService service.
+// All implementations must embed UnimplementedUFOServiceServer
+// for forward compatibility.
+//
+// UFOService вторая версия API наблюдений НЛО. Хранилище общее с ufo.v1:
+// наблюдение, созданное через одну версию, доступно через другую.
+type UFOServiceServer interface {
+	// CreateSighting создает новое наблюдение НЛО и возвращает его
+	CreateSighting(context.Context, *CreateSightingRequest) (*Sighting, error)
+	// GetSighting возвращает наблюдение НЛО по идентификатору
+	GetSighting(context.Context, *GetSightingRequest) (*Sighting, error)
+	// UpdateSighting обновляет поля наблюдения из update_mask и возвращает новую версию
+	UpdateSighting(context.Context, *UpdateSightingRequest) (*Sighting, error)
+	// DeleteSighting выполняет мягкое удаление наблюдения
+	DeleteSighting(context.Context, *DeleteSightingRequest) (*emptypb.Empty, error)
+	// ListSightings возвращает наблюдения НЛО, удаленные — только с show_deleted
+	ListSightings(context.Context, *ListSightingsRequest) (*ListSightingsResponse, error)
+	// StreamSightings передает наблюдения потоком по одному с тем же отбором, что ListSightings
+	StreamSightings(*ListSightingsRequest, grpc.ServerStreamingServer[Sighting]) error
+	mustEmbedUnimplementedUFOServiceServer()
+}
+
+// UnimplementedUFOServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUFOServiceServer struct{}
+
+func (UnimplementedUFOServiceServer) CreateSighting(context.Context, *CreateSightingRequest) (*Sighting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSighting not implemented")
+}
+func (UnimplementedUFOServiceServer) GetSighting(context.Context, *GetSightingRequest) (*Sighting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSighting not implemented")
+}
+func (UnimplementedUFOServiceServer) UpdateSighting(context.Context, *UpdateSightingRequest) (*Sighting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSighting not implemented")
+}
+func (UnimplementedUFOServiceServer) DeleteSighting(context.Context, *DeleteSightingRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSighting not implemented")
+}
+func (UnimplementedUFOServiceServer) ListSightings(context.Context, *ListSightingsRequest) (*ListSightingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSightings not implemented")
+}
+func (UnimplementedUFOServiceServer) StreamSightings(*ListSightingsRequest, grpc.ServerStreamingServer[Sighting]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSightings not implemented")
+}
+func (UnimplementedUFOServiceServer) mustEmbedUnimplementedUFOServiceServer() {}
+func (UnimplementedUFOServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeUFOServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UFOServiceServer will
+// result in compilation errors.
+type UnsafeUFOServiceServer interface {
+	mustEmbedUnimplementedUFOServiceServer()
+}
+
+func RegisterUFOServiceServer(s grpc.ServiceRegistrar, srv UFOServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUFOServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UFOService_ServiceDesc, srv)
+}
+
+func _UFOService_CreateSighting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSightingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UFOServiceServer).CreateSighting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UFOService_CreateSighting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UFOServiceServer).CreateSighting(ctx, req.(*CreateSightingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UFOService_GetSighting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSightingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UFOServiceServer).GetSighting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UFOService_GetSighting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UFOServiceServer).GetSighting(ctx, req.(*GetSightingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UFOService_UpdateSighting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSightingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UFOServiceServer).UpdateSighting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UFOService_UpdateSighting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UFOServiceServer).UpdateSighting(ctx, req.(*UpdateSightingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UFOService_DeleteSighting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSightingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UFOServiceServer).DeleteSighting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UFOService_DeleteSighting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UFOServiceServer).DeleteSighting(ctx, req.(*DeleteSightingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UFOService_ListSightings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSightingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UFOServiceServer).ListSightings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UFOService_ListSightings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UFOServiceServer).ListSightings(ctx, req.(*ListSightingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UFOService_StreamSightings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListSightingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UFOServiceServer).StreamSightings(m, &grpc.GenericServerStream[ListSightingsRequest, Sighting]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UFOService_StreamSightingsServer = grpc.ServerStreamingServer[Sighting]
+
+// UFOService_ServiceDesc is the grpc.ServiceDesc for UFOService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UFOService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ufo.v2.UFOService",
+	HandlerType: (*UFOServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSighting",
+			Handler:    _UFOService_CreateSighting_Handler,
+		},
+		{
+			MethodName: "GetSighting",
+			Handler:    _UFOService_GetSighting_Handler,
+		},
+		{
+			MethodName: "UpdateSighting",
+			Handler:    _UFOService_UpdateSighting_Handler,
+		},
+		{
+			MethodName: "DeleteSighting",
+			Handler:    _UFOService_DeleteSighting_Handler,
+		},
+		{
+			MethodName: "ListSightings",
+			Handler:    _UFOService_ListSightings_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamSightings",
+			Handler:       _UFOService_StreamSightings_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ufo/v2/ufo.proto",
+}
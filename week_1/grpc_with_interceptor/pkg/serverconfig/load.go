@@ -0,0 +1,267 @@
+package serverconfig
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// EnvPrefix префикс переменных окружения: grpc.port задается как UFO_GRPC_PORT.
+const EnvPrefix = "UFO_"
+
+const redacted = "******"
+
+// field лист структуры конфигурации с путем из yaml-тегов (например, gateway.read_timeout).
+type field struct {
+	path   string
+	value  reflect.Value
+	usage  string
+	secret bool
+}
+
+// Validator конфигурация, которая проверяет себя после загрузки.
+type Validator interface {
+	Validate() error
+}
+
+// Load заполняет cfg (указатель на структуру с yaml-тегами и значениями по
+// умолчанию) из YAML файла, переменных окружения и флагов командной строки.
+// Каждый следующий источник переопределяет предыдущий. Путь к YAML задается
+// флагом -config или переменной UFO_CONFIG. Флаги и переменные строятся по
+// путям yaml-тегов: gateway.read_timeout задается флагом -gateway.read-timeout
+// и переменной UFO_GATEWAY_READ_TIMEOUT. Загруженная конфигурация проверяется
+// через Validate, и первая же ошибка любого источника прерывает загрузку.
+func Load(name string, args []string, cfg Validator) error {
+	root := reflect.ValueOf(cfg)
+	if root.Kind() != reflect.Pointer || root.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("config must be a pointer to struct, got %T", cfg)
+	}
+
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	configPath := fs.String("config", os.Getenv(EnvPrefix+"CONFIG"), "путь к YAML файлу конфигурации")
+
+	fields := collect(root.Elem(), "")
+	for _, f := range fields {
+		fs.Var(&rawFlag{
+			def:    fmt.Sprint(f.value.Interface()),
+			isBool: f.value.Kind() == reflect.Bool,
+		}, flagName(f.path), f.usage)
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *configPath != "" {
+		if err := loadFile(cfg, *configPath); err != nil {
+			return err
+		}
+	}
+
+	var errs []error
+	for _, f := range fields {
+		env := envName(f.path)
+		raw, ok := os.LookupEnv(env)
+		if !ok {
+			continue
+		}
+		if err := setValue(f.value, raw); err != nil {
+			errs = append(errs, fmt.Errorf("env %s: %w", env, err))
+		}
+	}
+
+	byFlag := make(map[string]field, len(fields))
+	for _, f := range fields {
+		byFlag[flagName(f.path)] = f
+	}
+	fs.Visit(func(fl *flag.Flag) {
+		f, ok := byFlag[fl.Name]
+		if !ok {
+			return
+		}
+		if err := setValue(f.value, fl.Value.String()); err != nil {
+			errs = append(errs, fmt.Errorf("flag -%s: %w", fl.Name, err))
+		}
+	})
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+
+	return nil
+}
+
+// Redact заменяет звездочками непустые строковые поля с тегом secret:"true"
+// в структуре, на которую указывает cfg.
+func Redact(cfg interface{}) {
+	v := reflect.ValueOf(cfg)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return
+	}
+	for _, f := range collect(v.Elem(), "") {
+		if f.secret && f.value.Kind() == reflect.String && f.value.String() != "" {
+			f.value.SetString(redacted)
+		}
+	}
+}
+
+// Format возвращает конфигурацию cfg (структуру, не указатель) в YAML без
+// секретов; cfg не меняется.
+func Format(cfg interface{}) string {
+	v := reflect.ValueOf(cfg)
+	if v.Kind() != reflect.Struct {
+		return fmt.Sprintf("config: want struct, got %T", cfg)
+	}
+	copied := reflect.New(v.Type())
+	copied.Elem().Set(v)
+	Redact(copied.Interface())
+
+	out, err := yaml.Marshal(copied.Interface())
+	if err != nil {
+		return fmt.Sprintf("config: %v", err)
+	}
+	return string(out)
+}
+
+func loadFile(cfg interface{}, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read config file: %w", err)
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("parse config file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func collect(v reflect.Value, prefix string) []field {
+	var fields []field
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name, _, _ := strings.Cut(sf.Tag.Get("yaml"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+
+		fv := v.Field(i)
+		if fv.Kind() == reflect.Struct && fv.Type() != reflect.TypeOf(time.Duration(0)) {
+			fields = append(fields, collect(fv, path)...)
+			continue
+		}
+
+		fields = append(fields, field{
+			path:   path,
+			value:  fv,
+			usage:  sf.Tag.Get("usage"),
+			secret: sf.Tag.Get("secret") == "true",
+		})
+	}
+
+	return fields
+}
+
+func setValue(v reflect.Value, raw string) error {
+	if v.Type() == reflect.TypeOf(time.Duration(0)) {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported slice type %s", v.Type())
+		}
+		var items []string
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		v.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+
+	return nil
+}
+
+// flagName переводит путь gateway.read_timeout в имя флага gateway.read-timeout.
+func flagName(path string) string {
+	return strings.ReplaceAll(path, "_", "-")
+}
+
+// envName переводит путь gateway.read_timeout в UFO_GATEWAY_READ_TIMEOUT.
+func envName(path string) string {
+	return EnvPrefix + strings.ToUpper(strings.ReplaceAll(path, ".", "_"))
+}
+
+// rawFlag запоминает строковое значение флага, чтобы применить его после
+// YAML и переменных окружения.
+type rawFlag struct {
+	def    string
+	val    string
+	set    bool
+	isBool bool
+}
+
+func (f *rawFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	if f.set {
+		return f.val
+	}
+	return f.def
+}
+
+func (f *rawFlag) Set(s string) error {
+	f.val = s
+	f.set = true
+	return nil
+}
+
+// IsBoolFlag позволяет писать -gateway.enabled без значения.
+func (f *rawFlag) IsBoolFlag() bool {
+	return f.isBool
+}
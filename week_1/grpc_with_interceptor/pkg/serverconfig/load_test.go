@@ -0,0 +1,172 @@
+package serverconfig
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testConfig конфигурация сервера из общих секций.
+type testConfig struct {
+	Name   string     `yaml:"name" usage:"имя сервера"`
+	Token  string     `yaml:"token" secret:"true" usage:"секрет"`
+	GRPC   GRPCConfig `yaml:"grpc"`
+	HTTP   HTTPConfig `yaml:"http"`
+	Log    LogConfig  `yaml:"log"`
+	Tags   []string   `yaml:"tags" usage:"метки"`
+	Strict bool       `yaml:"strict" usage:"строгий режим"`
+}
+
+func (c testConfig) Validate() error {
+	return c.GRPC.Validate("grpc")
+}
+
+func defaults() testConfig {
+	return testConfig{
+		Name: "default",
+		GRPC: GRPCConfig{Port: 50051, ShutdownTimeout: 10 * time.Second},
+		HTTP: HTTPConfig{Port: 8081, ReadTimeout: 10 * time.Second},
+		Log:  LogConfig{Level: "info", Format: "text"},
+	}
+}
+
+func writeFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	path := writeFile(t, `
+name: from-yaml
+grpc:
+  port: 1000
+  shutdown_timeout: 3s
+http:
+  port: 1001
+  read_timeout: 4s
+log:
+  level: warn
+`)
+	// Каждое поле переопределяется на своем уровне: порт gRPC задан во всех
+	// трех источниках, порт HTTP — в YAML и окружении, таймауты — только в YAML.
+	t.Setenv("UFO_GRPC_PORT", "2000")
+	t.Setenv("UFO_HTTP_PORT", "2001")
+	t.Setenv("UFO_LOG_FORMAT", "json")
+	t.Setenv("UFO_TAGS", "a, b,,c")
+
+	cfg := defaults()
+	err := Load("test", []string{"-config", path, "-grpc.port", "3000", "-strict", "-http.read-timeout=7s"}, &cfg)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	checks := []struct {
+		name      string
+		got, want interface{}
+	}{
+		{"name from yaml", cfg.Name, "from-yaml"},
+		{"grpc.port from flag", cfg.GRPC.Port, 3000},
+		{"grpc.shutdown_timeout from yaml", cfg.GRPC.ShutdownTimeout, 3 * time.Second},
+		{"http.port from env", cfg.HTTP.Port, 2001},
+		{"http.read_timeout from flag", cfg.HTTP.ReadTimeout, 7 * time.Second},
+		{"log.level from yaml", cfg.Log.Level, "warn"},
+		{"log.format from env", cfg.Log.Format, "json"},
+		{"bool flag without value", cfg.Strict, true},
+		{"tags from env", strings.Join(cfg.Tags, "|"), "a|b|c"},
+	}
+	for _, c := range checks {
+		if c.got != c.want {
+			t.Errorf("%s = %v, want %v", c.name, c.got, c.want)
+		}
+	}
+}
+
+func TestLoadConfigPathFromEnv(t *testing.T) {
+	t.Setenv("UFO_CONFIG", writeFile(t, "name: from-env-path\n"))
+
+	cfg := defaults()
+	if err := Load("test", nil, &cfg); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Name != "from-env-path" {
+		t.Errorf("name = %q, want from-env-path", cfg.Name)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		env     map[string]string
+		args    []string
+		wantErr string
+	}{
+		{name: "unknown yaml field", yaml: "grpc:\n  prot: 1\n", wantErr: "field prot not found"},
+		{name: "bad yaml type", yaml: "grpc:\n  port: many\n", wantErr: "parse config file"},
+		{name: "bad env value", env: map[string]string{"UFO_GRPC_PORT": "many"}, wantErr: "env UFO_GRPC_PORT"},
+		{name: "bad env duration", env: map[string]string{"UFO_HTTP_READ_TIMEOUT": "10"}, wantErr: "env UFO_HTTP_READ_TIMEOUT"},
+		{name: "bad flag value", args: []string{"-strict=maybe"}, wantErr: "flag -strict"},
+		{name: "unknown flag", args: []string{"-grpc.prot", "1"}, wantErr: "flag provided but not defined"},
+		{name: "missing file", args: []string{"-config", "/nonexistent/config.yaml"}, wantErr: "read config file"},
+		{name: "validation fails", args: []string{"-grpc.port", "0"}, wantErr: "invalid config: grpc.port: must be between 1 and 65535, got 0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			args := tt.args
+			if tt.yaml != "" {
+				args = append([]string{"-config", writeFile(t, tt.yaml)}, args...)
+			}
+
+			cfg := defaults()
+			err := Load("test", args, &cfg)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Load error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadRequiresStructPointer(t *testing.T) {
+	if err := Load("test", nil, defaults()); err == nil {
+		t.Fatal("Load with a struct value: error = nil, want pointer error")
+	}
+}
+
+func TestRedactAndFormat(t *testing.T) {
+	cfg := defaults()
+	cfg.Token = "s3cr3t"
+
+	out := Format(cfg)
+	if strings.Contains(out, "s3cr3t") || !strings.Contains(out, "token: '******'") {
+		t.Errorf("Format shows the secret or drops the field:\n%s", out)
+	}
+	if !strings.Contains(out, "port: 50051") {
+		t.Errorf("Format lost regular fields:\n%s", out)
+	}
+	if cfg.Token != "s3cr3t" {
+		t.Errorf("Format changed the original config: token = %q", cfg.Token)
+	}
+
+	Redact(&cfg)
+	if cfg.Token != redacted {
+		t.Errorf("Redact: token = %q, want %q", cfg.Token, redacted)
+	}
+
+	// Пустой секрет остается пустым: по нему видно, что он не задан.
+	empty := defaults()
+	Redact(&empty)
+	if empty.Token != "" {
+		t.Errorf("Redact of empty secret = %q, want empty", empty.Token)
+	}
+}
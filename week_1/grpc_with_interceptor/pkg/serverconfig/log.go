@@ -0,0 +1,30 @@
+package serverconfig
+
+import (
+	"log"
+	"log/slog"
+	"os"
+)
+
+// SetupLogger настраивает slog по LogConfig. Сервер пишет логи через slog с
+// уровнями: debug — начало каждого вызова, info — штатные события, warn —
+// неудачные вызовы и повторы, error — сбои. Оставшиеся вызовы пакета log
+// попадают в тот же обработчик с уровнем info.
+func SetupLogger(c LogConfig) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.Level)); err != nil {
+		level = slog.LevelInfo
+	}
+
+	opts := &slog.HandlerOptions{Level: level}
+
+	var handler slog.Handler
+	if c.Format == "json" {
+		handler = slog.NewJSONHandler(os.Stderr, opts)
+	} else {
+		handler = slog.NewTextHandler(os.Stderr, opts)
+	}
+
+	slog.SetDefault(slog.New(handler))
+	log.SetFlags(0)
+}
@@ -0,0 +1,131 @@
+// Package serverconfig общая конфигурация серверов week_1: загрузка из YAML,
+// переменных окружения UFO_* и флагов (Load), печать без секретов (Format) и
+// секции, одинаковые для всех серверов, — адреса и таймауты gRPC и HTTP,
+// хранилище, логи и интерцепторы. Каждый сервер собирает из секций свою
+// структуру Config и задает значения по умолчанию. Поля с тегом
+// secret:"true" скрываются при печати конфигурации.
+package serverconfig
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+)
+
+// GRPCConfig настройки gRPC сервера.
+type GRPCConfig struct {
+	Host string `yaml:"host" usage:"адрес, на котором слушает gRPC сервер"`
+	Port int    `yaml:"port" usage:"порт gRPC сервера"`
+	// ShutdownTimeout время на GracefulStop, после которого соединения закрываются принудительно.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" usage:"таймаут graceful shutdown gRPC сервера"`
+}
+
+// Address возвращает адрес для net.Listen.
+func (c GRPCConfig) Address() string {
+	return net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
+}
+
+// DialAddress возвращает адрес, по которому к серверу подключается gateway.
+func (c GRPCConfig) DialAddress() string {
+	host := c.Host
+	if host == "" || host == "0.0.0.0" || host == "::" {
+		host = "localhost"
+	}
+	return net.JoinHostPort(host, strconv.Itoa(c.Port))
+}
+
+// Validate проверяет секцию, path — ее путь в YAML (например, grpc).
+func (c GRPCConfig) Validate(path string) error {
+	return errors.Join(
+		checkPort(path+".port", c.Port),
+		checkPositive(path+".shutdown_timeout", c.ShutdownTimeout),
+	)
+}
+
+// HTTPConfig настройки HTTP сервера.
+type HTTPConfig struct {
+	Host              string        `yaml:"host" usage:"адрес, на котором слушает HTTP сервер"`
+	Port              int           `yaml:"port" usage:"порт HTTP сервера"`
+	ReadTimeout       time.Duration `yaml:"read_timeout" usage:"таймаут чтения запроса"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout" usage:"таймаут чтения заголовков запроса"`
+	WriteTimeout      time.Duration `yaml:"write_timeout" usage:"таймаут записи ответа"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout" usage:"таймаут простоя keep-alive соединения"`
+	ShutdownTimeout   time.Duration `yaml:"shutdown_timeout" usage:"таймаут graceful shutdown HTTP сервера"`
+}
+
+// Address возвращает адрес для http.Server.
+func (c HTTPConfig) Address() string {
+	return net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
+}
+
+// Validate проверяет секцию, path — ее путь в YAML (например, http).
+func (c HTTPConfig) Validate(path string) error {
+	return errors.Join(
+		checkPort(path+".port", c.Port),
+		checkPositive(path+".read_timeout", c.ReadTimeout),
+		checkPositive(path+".read_header_timeout", c.ReadHeaderTimeout),
+		checkPositive(path+".write_timeout", c.WriteTimeout),
+		checkPositive(path+".idle_timeout", c.IdleTimeout),
+		checkPositive(path+".shutdown_timeout", c.ShutdownTimeout),
+	)
+}
+
+// StorageMemory хранилище наблюдений в памяти процесса.
+const StorageMemory = "memory"
+
+// StorageConfig выбор хранилища наблюдений.
+type StorageConfig struct {
+	Backend string `yaml:"backend" usage:"бэкенд хранилища наблюдений (memory)"`
+}
+
+// Validate проверяет секцию, path — ее путь в YAML (например, storage).
+func (c StorageConfig) Validate(path string) error {
+	if c.Backend != StorageMemory {
+		return fmt.Errorf("%s.backend: unsupported backend %q", path, c.Backend)
+	}
+	return nil
+}
+
+// LogConfig настройки логирования.
+type LogConfig struct {
+	Level  string `yaml:"level" usage:"уровень логирования (debug, info, warn, error)"`
+	Format string `yaml:"format" usage:"формат логов (text, json)"`
+}
+
+// Validate проверяет секцию, path — ее путь в YAML (например, log).
+func (c LogConfig) Validate(path string) error {
+	var errs []error
+	switch c.Level {
+	case "debug", "info", "warn", "error":
+	default:
+		errs = append(errs, fmt.Errorf("%s.level: unsupported level %q", path, c.Level))
+	}
+	switch c.Format {
+	case "text", "json":
+	default:
+		errs = append(errs, fmt.Errorf("%s.format: unsupported format %q", path, c.Format))
+	}
+	return errors.Join(errs...)
+}
+
+// InterceptorConfig включает и выключает серверные интерцепторы.
+type InterceptorConfig struct {
+	Logging    bool `yaml:"logging" usage:"логировать gRPC вызовы"`
+	Validation bool `yaml:"validation" usage:"валидировать входящие запросы"`
+}
+
+func checkPort(name string, port int) error {
+	if port < 1 || port > 65535 {
+		return fmt.Errorf("%s: must be between 1 and 65535, got %d", name, port)
+	}
+	return nil
+}
+
+func checkPositive(name string, d time.Duration) error {
+	if d <= 0 {
+		return fmt.Errorf("%s: must be positive, got %v", name, d)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package serverconfig
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSectionValidate(t *testing.T) {
+	validHTTP := HTTPConfig{
+		Port:              8080,
+		ReadTimeout:       time.Second,
+		ReadHeaderTimeout: time.Second,
+		WriteTimeout:      time.Second,
+		IdleTimeout:       time.Second,
+		ShutdownTimeout:   time.Second,
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		// wantErr подстроки ошибки; пусто — секция валидна.
+		wantErr []string
+	}{
+		{name: "grpc valid", err: GRPCConfig{Port: 50051, ShutdownTimeout: time.Second}.Validate("grpc")},
+		{
+			name:    "grpc port and timeout",
+			err:     GRPCConfig{Port: 70000}.Validate("grpc"),
+			wantErr: []string{"grpc.port: must be between 1 and 65535, got 70000", "grpc.shutdown_timeout: must be positive"},
+		},
+		{name: "http valid", err: validHTTP.Validate("http")},
+		{
+			name: "http zero timeouts",
+			err:  HTTPConfig{Port: 8080}.Validate("gateway"),
+			wantErr: []string{
+				"gateway.read_timeout", "gateway.read_header_timeout", "gateway.write_timeout",
+				"gateway.idle_timeout", "gateway.shutdown_timeout",
+			},
+		},
+		{name: "storage valid", err: StorageConfig{Backend: StorageMemory}.Validate("storage")},
+		{name: "storage backend", err: StorageConfig{Backend: "postgres"}.Validate("storage"), wantErr: []string{`storage.backend: unsupported backend "postgres"`}},
+		{name: "log valid", err: LogConfig{Level: "debug", Format: "json"}.Validate("log")},
+		{
+			name:    "log level and format",
+			err:     LogConfig{Level: "trace", Format: "xml"}.Validate("log"),
+			wantErr: []string{`log.level: unsupported level "trace"`, `log.format: unsupported format "xml"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.wantErr) == 0 {
+				if tt.err != nil {
+					t.Fatalf("unexpected error: %v", tt.err)
+				}
+				return
+			}
+			if tt.err == nil {
+				t.Fatalf("error = nil, want %q", tt.wantErr)
+			}
+			for _, want := range tt.wantErr {
+				if !strings.Contains(tt.err.Error(), want) {
+					t.Errorf("error %q does not contain %q", tt.err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestGRPCDialAddress(t *testing.T) {
+	tests := map[string]string{
+		"":          "localhost:50051",
+		"0.0.0.0":   "localhost:50051",
+		"::":        "localhost:50051",
+		"10.0.0.1":  "10.0.0.1:50051",
+		"localhost": "localhost:50051",
+	}
+	for host, want := range tests {
+		c := GRPCConfig{Host: host, Port: 50051}
+		if got := c.DialAddress(); got != want {
+			t.Errorf("DialAddress(host %q) = %q, want %q", host, got, want)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/serverconfig"
+	"google.golang.org/grpc"
+)
+
+// Config конфигурация gRPC сервера и gateway: YAML (-config или UFO_CONFIG),
+// переменные окружения UFO_* и флаги, см. serverconfig.Load.
+type Config struct {
+	GRPC    serverconfig.GRPCConfig    `yaml:"grpc"`
+	Gateway serverconfig.HTTPConfig    `yaml:"gateway"`
+	Storage serverconfig.StorageConfig `yaml:"storage"`
+	Log     serverconfig.LogConfig     `yaml:"log"`
+}
+
+func defaultConfig() Config {
+	return Config{
+		GRPC: serverconfig.GRPCConfig{
+			Port:            50051,
+			ShutdownTimeout: 10 * time.Second,
+		},
+		Gateway: serverconfig.HTTPConfig{
+			Port:              8081,
+			ReadTimeout:       10 * time.Second,
+			ReadHeaderTimeout: 5 * time.Second,
+			WriteTimeout:      30 * time.Second,
+			IdleTimeout:       60 * time.Second,
+			ShutdownTimeout:   10 * time.Second,
+		},
+		Storage: serverconfig.StorageConfig{Backend: serverconfig.StorageMemory},
+		Log:     serverconfig.LogConfig{Level: "info", Format: "text"},
+	}
+}
+
+// Validate проверяет конфигурацию и возвращает все найденные ошибки.
+func (c Config) Validate() error {
+	var portClash error
+	if c.Gateway.Port == c.GRPC.Port && c.Gateway.Host == c.GRPC.Host {
+		portClash = errors.New("gateway.port: must differ from grpc.port")
+	}
+	return errors.Join(
+		c.GRPC.Validate("grpc"),
+		c.Gateway.Validate("gateway"),
+		portClash,
+		c.Storage.Validate("storage"),
+		c.Log.Validate("log"),
+	)
+}
+
+func loadConfig(name string, args []string) (Config, error) {
+	cfg := defaultConfig()
+	if err := serverconfig.Load(name, args, &cfg); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// gracefulStop ждет завершения активных вызовов не дольше timeout, затем
+// закрывает соединения принудительно.
+func gracefulStop(s *grpc.Server, timeout time.Duration) {
+	done := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		log.Printf("⚠️ Graceful stop timed out after %v, forcing stop\n", timeout)
+		s.Stop()
+	}
+}
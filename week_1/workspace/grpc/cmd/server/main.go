@@ -2,7 +2,6 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"net"
 	"net/http"
@@ -15,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/serverconfig"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
@@ -24,10 +24,8 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-const (
-	grpcPort = 50051
-	httpPort = 8081
-)
+// maxClockSkew насколько observed_at может опережать часы сервера.
+const maxClockSkew = time.Minute
 
 type ufoService struct {
 	ufo_v1.UnimplementedUFOServiceServer
@@ -46,6 +44,9 @@ func (u *ufoService) Create(_ context.Context, rq *ufo_v1.CreateRequest) (*ufo_v
 	if err := rq.Validate(); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
 	}
+	if err := checkObservedAt("info.observed_at", rq.GetInfo().GetObservedAt()); err != nil {
+		return nil, err
+	}
 	u.mu.Lock()
 	defer u.mu.Unlock()
 
@@ -71,6 +72,9 @@ func (u *ufoService) Create(_ context.Context, rq *ufo_v1.CreateRequest) (*ufo_v
 }
 
 func (u *ufoService) Delete(_ context.Context, req *ufo_v1.DeleteRequest) (*emptypb.Empty, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
 	u.mu.Lock()
 	defer u.mu.Unlock()
 
@@ -97,6 +101,9 @@ func (u *ufoService) GetAll(_ context.Context, req *ufo_v1.GetAllRequest) (*ufo_
 }
 
 func (u *ufoService) Get(ctx context.Context, req *ufo_v1.GetRequest) (*ufo_v1.GetResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
 	u.mu.RLock()
 	defer u.mu.RUnlock()
 	sighting, ok := u.sighting[req.GetUuid()]
@@ -109,6 +116,12 @@ func (u *ufoService) Get(ctx context.Context, req *ufo_v1.GetRequest) (*ufo_v1.G
 }
 
 func (u *ufoService) Update(_ context.Context, req *ufo_v1.UpdateRequest) (*emptypb.Empty, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+	if err := checkObservedAt("update_info.observed_at", req.GetUpdateInfo().GetObservedAt()); err != nil {
+		return nil, err
+	}
 	u.mu.Lock()
 	defer u.mu.Unlock()
 
@@ -151,8 +164,30 @@ func (u *ufoService) Update(_ context.Context, req *ufo_v1.UpdateRequest) (*empt
 	return &emptypb.Empty{}, nil
 }
 
+// checkObservedAt запрещает время наблюдения в будущем с допуском maxClockSkew
+// на расхождение часов; правилами protoc-gen-validate такой допуск не задать.
+func checkObservedAt(field string, observedAt *timestamppb.Timestamp) error {
+	if observedAt == nil {
+		return nil
+	}
+	if !observedAt.IsValid() {
+		return status.Errorf(codes.InvalidArgument, "validation error: %s: value must be a valid timestamp", field)
+	}
+	if observedAt.AsTime().After(time.Now().Add(maxClockSkew)) {
+		return status.Errorf(codes.InvalidArgument, "validation error: %s: value must not be in the future", field)
+	}
+	return nil
+}
+
 func main() {
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
+	cfg, err := loadConfig(os.Args[0], os.Args[1:])
+	if err != nil {
+		log.Fatalf("failed to load config: %v\n", err)
+	}
+	serverconfig.SetupLogger(cfg.Log)
+	log.Printf("⚙️ Effective config:\n%s", serverconfig.Format(cfg))
+
+	lis, err := net.Listen("tcp", cfg.GRPC.Address())
 	if err != nil {
 		log.Printf("failed to listen: %v\n", err)
 		return
@@ -173,36 +208,36 @@ func main() {
 	reflection.Register(s)
 
 	go func() {
-		log.Printf("🚀 gRPC server listening on %d\n", grpcPort)
-		err = s.Serve(lis)
+		log.Printf("🚀 gRPC server listening on %s\n", cfg.GRPC.Address())
+		err := s.Serve(lis)
 		if err != nil {
 			log.Printf("failed to serve: %v\n", err)
 			return
 		}
 	}()
 
-	var gwServer *http.Server
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mux := runtime.NewServeMux()
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	err = ufo_v1.RegisterUFOServiceHandlerFromEndpoint(ctx, mux, cfg.GRPC.DialAddress(), opts)
+	if err != nil {
+		log.Printf("Failed to register gateway: %v\n", err)
+		return
+	}
+	gwServer := &http.Server{
+		Addr:              cfg.Gateway.Address(),
+		Handler:           mux,
+		ReadTimeout:       cfg.Gateway.ReadTimeout,
+		ReadHeaderTimeout: cfg.Gateway.ReadHeaderTimeout,
+		WriteTimeout:      cfg.Gateway.WriteTimeout,
+		IdleTimeout:       cfg.Gateway.IdleTimeout,
+	}
 
 	go func() {
-		ctx, cancel := context.WithCancel(context.Background())
-		defer cancel()
-		mux := runtime.NewServeMux()
-		opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
-		err = ufo_v1.RegisterUFOServiceHandlerFromEndpoint(
-			ctx, mux, fmt.Sprintf("localhost:%d", grpcPort),
-			opts,
-		)
-		if err != nil {
-			log.Printf("Failed to register gateway: %v\n", err)
-			return
-		}
-		gwServer = &http.Server{
-			Addr:        fmt.Sprintf(":%d", httpPort),
-			Handler:     mux,
-			ReadTimeout: 10 * time.Second,
-		}
-		log.Printf("🌐 HTTP server with gRPC-Gateway listening on %d\n", httpPort)
-		err = gwServer.ListenAndServe()
+		log.Printf("🌐 HTTP server with gRPC-Gateway listening on %s\n", cfg.Gateway.Address())
+		err := gwServer.ListenAndServe()
 		if err != nil && err != http.ErrServerClosed {
 			log.Printf("Failed to serve HTTP: %v\n", err)
 			return
@@ -213,7 +248,13 @@ func main() {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
+	log.Println("🛑 Shutting down HTTP server...")
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), cfg.Gateway.ShutdownTimeout)
+	defer shutdownCancel()
+	if err := gwServer.Shutdown(shutdownCtx); err != nil {
+		log.Printf("failed to shutdown HTTP server: %v\n", err)
+	}
 	log.Println("🛑 Shutting down gRPC server...")
-	s.GracefulStop()
+	gracefulStop(s, cfg.GRPC.ShutdownTimeout)
 	log.Println("✅ Server stopped")
 }
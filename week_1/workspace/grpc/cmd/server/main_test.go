@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	ufo_v1 "github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/proto/ufo/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const missingUUID = "6f1c2b9e-3d4a-4b5c-8e7f-0a1b2c3d4e5f"
+
+func TestServiceValidation(t *testing.T) {
+	ctx := context.Background()
+	svc := NewUfoService()
+
+	created, err := svc.Create(ctx, &ufo_v1.CreateRequest{Info: &ufo_v1.SightingInfo{
+		ObservedAt: timestamppb.New(time.Now().Add(-time.Hour)),
+		Location:   "Розуэлл",
+	}})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	id := created.GetUuid()
+
+	future := timestamppb.New(time.Now().Add(time.Hour))
+	// В пределах maxClockSkew время считается не будущим.
+	skewed := timestamppb.New(time.Now().Add(maxClockSkew / 2))
+	invalid := &timestamppb.Timestamp{Seconds: 1, Nanos: -1}
+
+	tests := []struct {
+		name     string
+		call     func() error
+		wantCode codes.Code
+		wantMsg  string
+	}{
+		{
+			name: "create in the future",
+			call: func() error {
+				_, err := svc.Create(ctx, &ufo_v1.CreateRequest{Info: &ufo_v1.SightingInfo{ObservedAt: future, Location: "Марс"}})
+				return err
+			},
+			wantCode: codes.InvalidArgument,
+			wantMsg:  "info.observed_at: value must not be in the future",
+		},
+		{
+			name: "create with invalid timestamp",
+			call: func() error {
+				_, err := svc.Create(ctx, &ufo_v1.CreateRequest{Info: &ufo_v1.SightingInfo{ObservedAt: invalid, Location: "Марс"}})
+				return err
+			},
+			wantCode: codes.InvalidArgument,
+			wantMsg:  "info.observed_at: value must be a valid timestamp",
+		},
+		{
+			name: "create within clock skew",
+			call: func() error {
+				_, err := svc.Create(ctx, &ufo_v1.CreateRequest{Info: &ufo_v1.SightingInfo{ObservedAt: skewed, Location: "Марс"}})
+				return err
+			},
+		},
+		{
+			name: "create without location",
+			call: func() error {
+				_, err := svc.Create(ctx, &ufo_v1.CreateRequest{Info: &ufo_v1.SightingInfo{}})
+				return err
+			},
+			wantCode: codes.InvalidArgument,
+			wantMsg:  "Location",
+		},
+		{
+			name: "get with invalid uuid",
+			call: func() error {
+				_, err := svc.Get(ctx, &ufo_v1.GetRequest{Uuid: "42"})
+				return err
+			},
+			wantCode: codes.InvalidArgument,
+			wantMsg:  "Uuid",
+		},
+		{
+			name: "get missing",
+			call: func() error {
+				_, err := svc.Get(ctx, &ufo_v1.GetRequest{Uuid: missingUUID})
+				return err
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "get existing",
+			call: func() error {
+				_, err := svc.Get(ctx, &ufo_v1.GetRequest{Uuid: id})
+				return err
+			},
+		},
+		{
+			name: "update with invalid uuid",
+			call: func() error {
+				_, err := svc.Update(ctx, &ufo_v1.UpdateRequest{Uuid: "42", UpdateInfo: &ufo_v1.SightingUpdateInfo{}})
+				return err
+			},
+			wantCode: codes.InvalidArgument,
+			wantMsg:  "Uuid",
+		},
+		{
+			name: "update without update_info",
+			call: func() error {
+				_, err := svc.Update(ctx, &ufo_v1.UpdateRequest{Uuid: id})
+				return err
+			},
+			wantCode: codes.InvalidArgument,
+			wantMsg:  "UpdateInfo",
+		},
+		{
+			name: "update in the future",
+			call: func() error {
+				_, err := svc.Update(ctx, &ufo_v1.UpdateRequest{Uuid: id, UpdateInfo: &ufo_v1.SightingUpdateInfo{ObservedAt: future}})
+				return err
+			},
+			wantCode: codes.InvalidArgument,
+			wantMsg:  "update_info.observed_at: value must not be in the future",
+		},
+		{
+			name: "update with empty location",
+			call: func() error {
+				_, err := svc.Update(ctx, &ufo_v1.UpdateRequest{Uuid: id, UpdateInfo: &ufo_v1.SightingUpdateInfo{Location: wrapperspb.String("")}})
+				return err
+			},
+			wantCode: codes.InvalidArgument,
+			wantMsg:  "Location",
+		},
+		{
+			name: "update missing",
+			call: func() error {
+				_, err := svc.Update(ctx, &ufo_v1.UpdateRequest{Uuid: missingUUID, UpdateInfo: &ufo_v1.SightingUpdateInfo{}})
+				return err
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "update existing",
+			call: func() error {
+				_, err := svc.Update(ctx, &ufo_v1.UpdateRequest{Uuid: id, UpdateInfo: &ufo_v1.SightingUpdateInfo{ObservedAt: skewed}})
+				return err
+			},
+		},
+		{
+			name: "delete with invalid uuid",
+			call: func() error {
+				_, err := svc.Delete(ctx, &ufo_v1.DeleteRequest{Uuid: "42"})
+				return err
+			},
+			wantCode: codes.InvalidArgument,
+			wantMsg:  "Uuid",
+		},
+		{
+			name: "delete missing",
+			call: func() error {
+				_, err := svc.Delete(ctx, &ufo_v1.DeleteRequest{Uuid: missingUUID})
+				return err
+			},
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			st := status.Convert(err)
+			if st.Code() != tt.wantCode {
+				t.Fatalf("code = %v (%v), want %v", st.Code(), err, tt.wantCode)
+			}
+			if tt.wantMsg != "" && !strings.Contains(st.Message(), tt.wantMsg) {
+				t.Errorf("message = %q, want containing %q", st.Message(), tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestCheckObservedAt(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		observedAt *timestamppb.Timestamp
+		wantErr    bool
+	}{
+		{name: "not set"},
+		{name: "past", observedAt: timestamppb.New(now.Add(-24 * time.Hour))},
+		{name: "now", observedAt: timestamppb.New(now)},
+		{name: "within skew", observedAt: timestamppb.New(now.Add(maxClockSkew - time.Second))},
+		{name: "beyond skew", observedAt: timestamppb.New(now.Add(maxClockSkew + time.Second)), wantErr: true},
+		{name: "invalid nanos", observedAt: &timestamppb.Timestamp{Nanos: 2e9}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkObservedAt("info.observed_at", tt.observedAt)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkObservedAt() = %v, want error: %v", err, tt.wantErr)
+			}
+			if err != nil && status.Code(err) != codes.InvalidArgument {
+				t.Errorf("code = %v, want InvalidArgument", status.Code(err))
+			}
+		})
+	}
+}
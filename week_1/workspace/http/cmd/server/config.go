@@ -0,0 +1,49 @@
+package main
+
+import (
+	"errors"
+	"time"
+
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/serverconfig"
+)
+
+// Config конфигурация HTTP сервера погоды: YAML (-config или UFO_CONFIG),
+// переменные окружения UFO_* и флаги, см. serverconfig.Load.
+type Config struct {
+	HTTP    serverconfig.HTTPConfig    `yaml:"http"`
+	Storage serverconfig.StorageConfig `yaml:"storage"`
+	Log     serverconfig.LogConfig     `yaml:"log"`
+}
+
+func defaultConfig() Config {
+	return Config{
+		HTTP: serverconfig.HTTPConfig{
+			Host:              "localhost",
+			Port:              8080,
+			ReadTimeout:       10 * time.Second,
+			ReadHeaderTimeout: 5 * time.Second,
+			WriteTimeout:      30 * time.Second,
+			IdleTimeout:       60 * time.Second,
+			ShutdownTimeout:   10 * time.Second,
+		},
+		Storage: serverconfig.StorageConfig{Backend: serverconfig.StorageMemory},
+		Log:     serverconfig.LogConfig{Level: "info", Format: "text"},
+	}
+}
+
+// Validate проверяет конфигурацию и возвращает все найденные ошибки.
+func (c Config) Validate() error {
+	return errors.Join(
+		c.HTTP.Validate("http"),
+		c.Storage.Validate("storage"),
+		c.Log.Validate("log"),
+	)
+}
+
+func loadConfig(name string, args []string) (Config, error) {
+	cfg := defaultConfig()
+	if err := serverconfig.Load(name, args, &cfg); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"log"
-	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -14,16 +13,10 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"github.com/mbakhodurov/examples/week_1/grpc_with_interceptor/pkg/serverconfig"
 	weather_v1 "github.com/mbakhodurov/examples/week_1/workspace/shared/pkg/openapi/weather/v1"
 )
 
-const (
-	httpPort = "8080"
-	// Таймауты для HTTP-сервера
-	readHeaderTimeout = 5 * time.Second
-	shutdownTimeout   = 10 * time.Second
-)
-
 type WeatherStorage struct {
 	mu       sync.RWMutex
 	weathers map[string]*weather_v1.Weather
@@ -95,6 +88,13 @@ func (h *WeatherHandler) NewError(_ context.Context, err error) *weather_v1.Gene
 }
 
 func main() {
+	cfg, err := loadConfig(os.Args[0], os.Args[1:])
+	if err != nil {
+		log.Fatalf("ошибка загрузки конфигурации: %v", err)
+	}
+	serverconfig.SetupLogger(cfg.Log)
+	log.Printf("⚙️ Действующая конфигурация:\n%s", serverconfig.Format(cfg))
+
 	storage := NewWeatherStorage()
 
 	weatherHandler := NewWeatherHandler(storage)
@@ -114,13 +114,16 @@ func main() {
 	r.Mount("/", weatherServer)
 
 	server := &http.Server{
-		Addr:              net.JoinHostPort("localhost", httpPort),
+		Addr:              cfg.HTTP.Address(),
 		Handler:           r,
-		ReadHeaderTimeout: readHeaderTimeout,
+		ReadTimeout:       cfg.HTTP.ReadTimeout,
+		ReadHeaderTimeout: cfg.HTTP.ReadHeaderTimeout,
+		WriteTimeout:      cfg.HTTP.WriteTimeout,
+		IdleTimeout:       cfg.HTTP.IdleTimeout,
 	}
 
 	go func() {
-		log.Printf("🚀 HTTP-сервер запущен на порту %s\n", httpPort)
+		log.Printf("🚀 HTTP-сервер запущен на %s\n", cfg.HTTP.Address())
 		err = server.ListenAndServe()
 		if err != nil && !errors.Is(err, http.ErrServerClosed) {
 			log.Printf("❌ Ошибка запуска сервера: %v\n", err)
@@ -135,7 +138,7 @@ func main() {
 	log.Println("🛑 Завершение работы сервера...")
 
 	// Создаем контекст с таймаутом для остановки сервера
-	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.HTTP.ShutdownTimeout)
 	defer cancel()
 
 	err = server.Shutdown(ctx)
@@ -4,6 +4,7 @@ go 1.25.4
 
 require (
 	github.com/go-chi/chi/v5 v5.2.3
+	github.com/mbakhodurov/examples/week_1/grpc_with_interceptor v0.0.0-20251129175116-f0d61e2a27d1
 	github.com/mbakhodurov/examples/week_1/http_chi_ogen v0.0.0-20251129175116-f0d61e2a27d1
 )
 
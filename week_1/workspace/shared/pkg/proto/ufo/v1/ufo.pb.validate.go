@@ -35,6 +35,9 @@ var (
 	_ = sort.Sort
 )
 
+// define the regex for a UUID once up-front
+var _ufo_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
 // Validate checks the field values on SightingInfo with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -97,35 +100,30 @@ func (m *SightingInfo) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Description
-
-	if all {
-		switch v := interface{}(m.GetColor()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SightingInfoValidationError{
-					field:  "Color",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SightingInfoValidationError{
-					field:  "Color",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetDescription()) > 1000 {
+		err := SightingInfoValidationError{
+			field:  "Description",
+			reason: "value length must be at most 1000 runes",
 		}
-	} else if v, ok := interface{}(m.GetColor()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SightingInfoValidationError{
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if wrapper := m.GetColor(); wrapper != nil {
+
+		if l := utf8.RuneCountInString(wrapper.GetValue()); l < 1 || l > 32 {
+			err := SightingInfoValidationError{
 				field:  "Color",
-				reason: "embedded message failed validation",
-				cause:  err,
+				reason: "value length must be between 1 and 32 runes, inclusive",
+			}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
+
 	}
 
 	if all {
@@ -157,33 +155,19 @@ func (m *SightingInfo) validate(all bool) error {
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetDurationSeconds()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SightingInfoValidationError{
-					field:  "DurationSeconds",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SightingInfoValidationError{
-					field:  "DurationSeconds",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDurationSeconds()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SightingInfoValidationError{
+	if wrapper := m.GetDurationSeconds(); wrapper != nil {
+
+		if val := wrapper.GetValue(); val <= 0 || val > 86400 {
+			err := SightingInfoValidationError{
 				field:  "DurationSeconds",
-				reason: "embedded message failed validation",
-				cause:  err,
+				reason: "value must be inside range (0, 86400]",
+			}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
+
 	}
 
 	if len(errors) > 0 {
@@ -314,91 +298,49 @@ func (m *SightingUpdateInfo) validate(all bool) error {
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetLocation()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "Location",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "Location",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SightingUpdateInfoValidationError{
+	if wrapper := m.GetLocation(); wrapper != nil {
+
+		if l := utf8.RuneCountInString(wrapper.GetValue()); l < 1 || l > 50 {
+			err := SightingUpdateInfoValidationError{
 				field:  "Location",
-				reason: "embedded message failed validation",
-				cause:  err,
+				reason: "value length must be between 1 and 50 runes, inclusive",
 			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
+
 	}
 
-	if all {
-		switch v := interface{}(m.GetDescription()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "Description",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "Description",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDescription()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SightingUpdateInfoValidationError{
+	if wrapper := m.GetDescription(); wrapper != nil {
+
+		if utf8.RuneCountInString(wrapper.GetValue()) > 1000 {
+			err := SightingUpdateInfoValidationError{
 				field:  "Description",
-				reason: "embedded message failed validation",
-				cause:  err,
+				reason: "value length must be at most 1000 runes",
 			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
+
 	}
 
-	if all {
-		switch v := interface{}(m.GetColor()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "Color",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "Color",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetColor()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SightingUpdateInfoValidationError{
+	if wrapper := m.GetColor(); wrapper != nil {
+
+		if l := utf8.RuneCountInString(wrapper.GetValue()); l < 1 || l > 32 {
+			err := SightingUpdateInfoValidationError{
 				field:  "Color",
-				reason: "embedded message failed validation",
-				cause:  err,
+				reason: "value length must be between 1 and 32 runes, inclusive",
+			}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
+
 	}
 
 	if all {
@@ -430,33 +372,19 @@ func (m *SightingUpdateInfo) validate(all bool) error {
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetDurationSeconds()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "DurationSeconds",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SightingUpdateInfoValidationError{
-					field:  "DurationSeconds",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDurationSeconds()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SightingUpdateInfoValidationError{
+	if wrapper := m.GetDurationSeconds(); wrapper != nil {
+
+		if val := wrapper.GetValue(); val <= 0 || val > 86400 {
+			err := SightingUpdateInfoValidationError{
 				field:  "DurationSeconds",
-				reason: "embedded message failed validation",
-				cause:  err,
+				reason: "value must be inside range (0, 86400]",
+			}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
+
 	}
 
 	if len(errors) > 0 {
@@ -1245,7 +1173,17 @@ func (m *GetRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Uuid
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = GetRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
 		return GetRequestMultiError(errors)
@@ -1254,6 +1192,14 @@ func (m *GetRequest) validate(all bool) error {
 	return nil
 }
 
+func (m *GetRequest) _validateUuid(uuid string) error {
+	if matched := _ufo_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
 // GetRequestMultiError is an error wrapping multiple validation errors
 // returned by GetRequest.ValidateAll() if the designated constraints aren't met.
 type GetRequestMultiError []error
@@ -1474,7 +1420,28 @@ func (m *UpdateRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Uuid
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = UpdateRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUpdateInfo() == nil {
+		err := UpdateRequestValidationError{
+			field:  "UpdateInfo",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if all {
 		switch v := interface{}(m.GetUpdateInfo()).(type) {
@@ -1512,6 +1479,14 @@ func (m *UpdateRequest) validate(all bool) error {
 	return nil
 }
 
+func (m *UpdateRequest) _validateUuid(uuid string) error {
+	if matched := _ufo_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
 // UpdateRequestMultiError is an error wrapping multiple validation errors
 // returned by UpdateRequest.ValidateAll() if the designated constraints
 // aren't met.
@@ -1605,7 +1580,17 @@ func (m *DeleteRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Uuid
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = DeleteRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
 		return DeleteRequestMultiError(errors)
@@ -1614,6 +1599,14 @@ func (m *DeleteRequest) validate(all bool) error {
 	return nil
 }
 
+func (m *DeleteRequest) _validateUuid(uuid string) error {
+	if matched := _ufo_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
 // DeleteRequestMultiError is an error wrapping multiple validation errors
 // returned by DeleteRequest.ValidateAll() if the designated constraints
 // aren't met.